@@ -0,0 +1,365 @@
+package azaisearch
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync/atomic"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// exportFormatVersion is the version of the export file format written by ExportIndex.
+const exportFormatVersion = 1
+
+// exportHeader is the first line of an export file.
+type exportHeader struct {
+	Version int                        `json:"version"`
+	Index   *searchservice.SearchIndex `json:"index"`
+}
+
+// exportCheckpoint is the state of an export, stored next to the export file while it is written.
+type exportCheckpoint struct {
+	IndexName string `json:"indexName"`
+	LastKey   string `json:"lastKey"`
+	Documents int64  `json:"documents"`
+	Offset    int64  `json:"offset"`
+}
+
+// ExportOptions contains the optional parameters for Client.ExportIndex.
+type ExportOptions struct {
+	// CheckpointInterval is the number of documents written between checkpoints. The default is 10000.
+	CheckpointInterval int
+
+	// Resume continues an interrupted export from its last checkpoint instead of starting over.
+	// Without a checkpoint, the export starts from the beginning.
+	Resume bool
+}
+
+// ExportResult contains the result of Client.ExportIndex.
+type ExportResult struct {
+	// Documents is the total number of documents in the export file.
+	Documents int64
+
+	// Resumed reports whether the export continued from a checkpoint.
+	Resumed bool
+}
+
+// ExportIndex writes the definition and all documents of the index to path as gzip-compressed
+// JSON Lines: a header line with the index definition, followed by one line per document.
+//
+// Documents are read in key order with DocumentsClient.ScanAll, so the key field must be filterable
+// and sortable. Fields that aren't retrievable, such as vector fields with retrievable set to false,
+// can't be exported. While the export runs, its progress is checkpointed to path + ".checkpoint" and
+// the file consists of one gzip member per checkpoint, so an interrupted export can be continued
+// with ExportOptions.Resume. The checkpoint is removed when the export completes.
+//   - indexName - the name of the index to export
+//   - path - the export file to write
+//   - options - ExportOptions contains the optional parameters, pass nil to accept the default values.
+func (c *Client) ExportIndex(ctx context.Context, indexName string, path string, options *ExportOptions) (ExportResult, error) {
+	opts := ExportOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.CheckpointInterval <= 0 {
+		opts.CheckpointInterval = 10000
+	}
+	checkpointPath := path + ".checkpoint"
+
+	var cp *exportCheckpoint
+	if opts.Resume {
+		var err error
+		if cp, err = readCheckpoint(checkpointPath); err != nil {
+			return ExportResult{}, err
+		}
+		if cp != nil && cp.IndexName != indexName {
+			return ExportResult{}, fmt.Errorf("checkpoint %s belongs to an export of index %q", checkpointPath, cp.IndexName)
+		}
+	}
+
+	index, err := c.Indexes().Get(ctx, indexName, nil, nil)
+	if err != nil {
+		return ExportResult{}, err
+	}
+	key, err := schema.KeyField(&index.SearchIndex)
+	if err != nil {
+		return ExportResult{}, err
+	}
+
+	w, err := openExportFile(path, cp)
+	if err != nil {
+		return ExportResult{}, err
+	}
+	defer w.file.Close()
+
+	result := ExportResult{Resumed: cp != nil}
+	// keep numbers as returned, so Edm.Int64 values are not rounded
+	scanOptions := &searchindex.ScanOptions{KeyField: *key.Name, UseNumber: true}
+	if cp == nil {
+		cp = &exportCheckpoint{IndexName: indexName}
+		if err := w.writeLine(exportHeader{Version: exportFormatVersion, Index: &index.SearchIndex}); err != nil {
+			return result, err
+		}
+		if err := w.checkpoint(cp, checkpointPath); err != nil {
+			return result, err
+		}
+	} else {
+		scanOptions.StartAfterKey = &cp.LastKey
+	}
+	result.Documents = cp.Documents
+
+	sinceCheckpoint := 0
+	for doc, err := range c.Documents(indexName).ScanAll(ctx, nil, nil, scanOptions) {
+		if err != nil {
+			return result, err
+		}
+		if err := w.writeLine(doc.Document); err != nil {
+			return result, err
+		}
+		result.Documents++
+		sinceCheckpoint++
+		if sinceCheckpoint == opts.CheckpointInterval {
+			cp.LastKey, cp.Documents = doc.Key, result.Documents
+			if err := w.checkpoint(cp, checkpointPath); err != nil {
+				return result, err
+			}
+			sinceCheckpoint = 0
+		}
+	}
+	if err := w.close(); err != nil {
+		return result, err
+	}
+	if err := os.Remove(checkpointPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return result, err
+	}
+	return result, nil
+}
+
+// exportWriter writes lines to an export file as a series of gzip members, one per checkpoint.
+type exportWriter struct {
+	file *os.File
+	gz   *gzip.Writer
+	buf  *bufio.Writer
+}
+
+// openExportFile creates the export file, or truncates it to the offset of cp to resume an export.
+func openExportFile(path string, cp *exportCheckpoint) (*exportWriter, error) {
+	if cp == nil {
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		return newExportWriter(f), nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Truncate(cp.Offset); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.Seek(cp.Offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return newExportWriter(f), nil
+}
+
+func newExportWriter(f *os.File) *exportWriter {
+	gz := gzip.NewWriter(f)
+	return &exportWriter{file: f, gz: gz, buf: bufio.NewWriter(gz)}
+}
+
+func (w *exportWriter) writeLine(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := w.buf.Write(b); err != nil {
+		return err
+	}
+	return w.buf.WriteByte('\n')
+}
+
+// close completes the current gzip member and syncs the file.
+func (w *exportWriter) close() error {
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	if err := w.gz.Close(); err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+// checkpoint completes the current gzip member, records its end offset in cp and saves cp
+// to path, then starts a new member.
+func (w *exportWriter) checkpoint(cp *exportCheckpoint, path string) error {
+	if err := w.close(); err != nil {
+		return err
+	}
+	offset, err := w.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	cp.Offset = offset
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	// write and rename, so a crash never leaves a partial checkpoint
+	if err := os.WriteFile(path+".tmp", b, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+	w.gz.Reset(w.file)
+	w.buf.Reset(w.gz)
+	return nil
+}
+
+func readCheckpoint(path string) (*exportCheckpoint, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cp exportCheckpoint
+	if err := json.Unmarshal(b, &cp); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %w", path, err)
+	}
+	return &cp, nil
+}
+
+// ImportOptions contains the optional parameters for Client.ImportIndex.
+type ImportOptions struct {
+	// IndexName is the name of the index to create. The default is the name of the exported index.
+	IndexName string
+
+	// UseExistingIndex uploads the documents into an existing index of that name instead of failing
+	// when it exists. The index definition in the export is not applied to it.
+	UseExistingIndex bool
+
+	// BatchSenderOptions configures the upload of the documents. OnActionFailed is called in
+	// addition to counting the failures.
+	BatchSenderOptions *BatchSenderOptions
+}
+
+// ImportResult contains the result of Client.ImportIndex.
+type ImportResult struct {
+	// IndexName is the name of the index the documents were uploaded to.
+	IndexName string
+
+	// Documents is the number of documents read from the export file.
+	Documents int64
+
+	// Failed is the number of documents that failed to upload.
+	Failed int64
+}
+
+// ImportIndex recreates an index from a file written by ExportIndex and uploads its documents.
+// The index is created from the exported definition, without its ETag; encryption keys and
+// other references to external resources must be valid for the target service.
+//   - path - the export file to read
+//   - options - ImportOptions contains the optional parameters, pass nil to accept the default values.
+func (c *Client) ImportIndex(ctx context.Context, path string, options *ImportOptions) (ImportResult, error) {
+	opts := ImportOptions{}
+	if options != nil {
+		opts = *options
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	defer gz.Close()
+	dec := json.NewDecoder(bufio.NewReader(gz))
+	// keep numbers as exported, so Edm.Int64 values are not rounded
+	dec.UseNumber()
+
+	var header exportHeader
+	if err := dec.Decode(&header); err != nil {
+		return ImportResult{}, fmt.Errorf("reading export header: %w", err)
+	}
+	if header.Version != exportFormatVersion || header.Index == nil || header.Index.Name == nil {
+		return ImportResult{}, fmt.Errorf("%s is not an index export", path)
+	}
+	index := *header.Index
+	index.ETag = nil
+	if opts.IndexName != "" {
+		index.Name = &opts.IndexName
+	}
+	result := ImportResult{IndexName: *index.Name}
+
+	if _, err := c.Indexes().Create(ctx, index, nil, nil); err != nil {
+		if !opts.UseExistingIndex || !isAlreadyExists(err) {
+			return result, err
+		}
+	}
+	key, err := schema.KeyField(&index)
+	if err != nil {
+		return result, err
+	}
+
+	senderOptions := BatchSenderOptions{}
+	if opts.BatchSenderOptions != nil {
+		senderOptions = *opts.BatchSenderOptions
+	}
+	var failed atomic.Int64
+	onFailed := senderOptions.OnActionFailed
+	senderOptions.OnActionFailed = func(action *searchindex.IndexAction, r *searchindex.IndexingResult, err error) {
+		failed.Add(1)
+		if onFailed != nil {
+			onFailed(action, r, err)
+		}
+	}
+	sender, err := NewBatchSender(c.Documents(*index.Name), *key.Name, &senderOptions)
+	if err != nil {
+		return result, err
+	}
+
+	upload := searchindex.IndexActionTypeUpload
+	for {
+		var doc map[string]any
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			_ = sender.Close(ctx)
+			return result, fmt.Errorf("reading document %d: %w", result.Documents+1, err)
+		}
+		result.Documents++
+		if err := sender.Add(ctx, &searchindex.IndexAction{ActionType: &upload, AdditionalProperties: doc}); err != nil {
+			_ = sender.Close(ctx)
+			return result, err
+		}
+	}
+	err = sender.Close(ctx)
+	result.Failed = failed.Load()
+	return result, err
+}
+
+// isAlreadyExists reports whether err is the service's response to creating a resource that already exists.
+func isAlreadyExists(err error) bool {
+	var respErr *azcore.ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusConflict
+}
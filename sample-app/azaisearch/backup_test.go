@@ -0,0 +1,153 @@
+package azaisearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+// backupService fakes the requests of ExportIndex and ImportIndex. The index "hotels" has the
+// documents 0000 to count-1, whose Edm.Int64 field views is 2^53 + n. Search requests after the
+// first failSearchAfter ones fail with 500 while failSearchAfter is positive.
+type backupService struct {
+	t               *testing.T
+	count           int
+	failSearchAfter int
+
+	mu       sync.Mutex
+	searches int
+	created  string
+	uploaded []map[string]any
+}
+
+func (s *backupService) Do(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch path := req.URL.Path; {
+	case req.Method == http.MethodGet && path == "/indexes('hotels')":
+		return jsonResponse(req, http.StatusOK, `{"name":"hotels","fields":[`+
+			`{"name":"id","type":"Edm.String","key":true,"filterable":true,"sortable":true},`+
+			`{"name":"views","type":"Edm.Int64"}]}`), nil
+	case req.Method == http.MethodPost && path == "/indexes('hotels')/docs/search.post.search":
+		s.searches++
+		if s.failSearchAfter > 0 && s.searches > s.failSearchAfter {
+			return jsonResponse(req, http.StatusInternalServerError, `{"error":{"code":"InternalError","message":"interrupted"}}`), nil
+		}
+		var body struct {
+			Filter  string `json:"filter"`
+			OrderBy string `json:"orderby"`
+			Top     int    `json:"top"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		if body.OrderBy != "id asc" {
+			s.t.Errorf("search orderby = %q, want %q", body.OrderBy, "id asc")
+		}
+		start := 0
+		if after, ok := strings.CutPrefix(body.Filter, "id gt '"); ok {
+			fmt.Sscanf(strings.TrimSuffix(after, "'"), "%d", &start)
+			start++
+		} else if body.Filter != "" {
+			s.t.Errorf("unexpected search filter %q", body.Filter)
+		}
+		var docs []string
+		for n := start; n < min(start+body.Top, s.count); n++ {
+			docs = append(docs, fmt.Sprintf(`{"@search.score":1,"id":"%04d","views":%d}`, n, int64(1<<53+n)))
+		}
+		return jsonResponse(req, http.StatusOK, `{"value":[`+strings.Join(docs, ",")+`]}`), nil
+	case req.Method == http.MethodPost && path == "/indexes":
+		var index struct {
+			Name string `json:"name"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&index); err != nil {
+			return nil, err
+		}
+		s.created = index.Name
+		return jsonResponse(req, http.StatusCreated, `{"name":"`+index.Name+`","fields":[]}`), nil
+	case req.Method == http.MethodPost && path == "/indexes('restored')/docs/search.index":
+		dec := json.NewDecoder(req.Body)
+		dec.UseNumber()
+		var batch struct {
+			Value []map[string]any `json:"value"`
+		}
+		if err := dec.Decode(&batch); err != nil {
+			return nil, err
+		}
+		var results []string
+		for _, doc := range batch.Value {
+			s.uploaded = append(s.uploaded, doc)
+			results = append(results, fmt.Sprintf(`{"key":%q,"status":true,"statusCode":201}`, doc["id"]))
+		}
+		return jsonResponse(req, http.StatusOK, `{"value":[`+strings.Join(results, ",")+`]}`), nil
+	}
+	s.t.Errorf("unexpected request %s %s", req.Method, req.URL)
+	return jsonResponse(req, http.StatusNotFound, `{}`), nil
+}
+
+func TestExportResumeImport(t *testing.T) {
+	const count = 1500
+	service := &backupService{t: t, count: count, failSearchAfter: 1}
+	client, err := NewClientWithSharedKey("https://test.search.windows.net", azcore.NewKeyCredential("key"), &ClientOptions{
+		ClientOptions: azcore.ClientOptions{Transport: service, Retry: policy.RetryOptions{MaxRetries: -1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "hotels.jsonl.gz")
+
+	// the first page of 1000 documents is checkpointed after 600, then the second page fails
+	options := &ExportOptions{CheckpointInterval: 600}
+	if _, err := client.ExportIndex(t.Context(), "hotels", path, options); err == nil {
+		t.Fatal("ExportIndex() with a failing page error = nil")
+	}
+	if _, err := os.Stat(path + ".checkpoint"); err != nil {
+		t.Fatalf("checkpoint after the interrupted export: %v", err)
+	}
+
+	service.failSearchAfter = 0
+	options.Resume = true
+	exported, err := client.ExportIndex(t.Context(), "hotels", path, options)
+	if err != nil {
+		t.Fatalf("ExportIndex() resumed error = %v", err)
+	}
+	if !exported.Resumed || exported.Documents != count {
+		t.Errorf("ExportIndex() = %+v, want resumed with %d documents", exported, count)
+	}
+	if _, err := os.Stat(path + ".checkpoint"); !os.IsNotExist(err) {
+		t.Errorf("checkpoint after the completed export: %v, want it removed", err)
+	}
+
+	imported, err := client.ImportIndex(t.Context(), path, &ImportOptions{IndexName: "restored"})
+	if err != nil {
+		t.Fatalf("ImportIndex() error = %v", err)
+	}
+	if imported.IndexName != "restored" || imported.Documents != count || imported.Failed != 0 {
+		t.Errorf("ImportIndex() = %+v, want %d documents in restored", imported, count)
+	}
+	if service.created != "restored" {
+		t.Errorf("created index %q, want %q", service.created, "restored")
+	}
+	if len(service.uploaded) != count {
+		t.Fatalf("uploaded %d documents, want %d", len(service.uploaded), count)
+	}
+	for n, doc := range service.uploaded {
+		id, views := fmt.Sprintf("%04d", n), json.Number(fmt.Sprint(int64(1<<53+n)))
+		if doc["id"] != id || doc["views"] != views {
+			t.Fatalf("uploaded document %d = %v, want id %s and views %s", n, doc, id, views)
+		}
+		if doc["@search.action"] != string(searchindex.IndexActionTypeUpload) {
+			t.Fatalf("uploaded document %d has action %v", n, doc["@search.action"])
+		}
+	}
+}
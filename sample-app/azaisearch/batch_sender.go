@@ -0,0 +1,411 @@
+package azaisearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+// ErrBatchSenderClosed is returned when actions are added to a closed BatchSender.
+var ErrBatchSenderClosed = errors.New("batch sender is closed")
+
+// BatchSenderOptions contains the optional parameters for NewBatchSender.
+type BatchSenderOptions struct {
+	// MaxBatchActions is the number of queued actions that triggers a flush and the
+	// maximum number of actions per request. The default (and service limit) is 1000;
+	// larger values are capped at it. Batches the service rejects as too large are
+	// split automatically.
+	MaxBatchActions int
+
+	// MaxBatchBytes is the estimated JSON payload size that triggers a flush and the
+	// maximum payload size per request. The default is 15 MiB, below the 16 MB service limit.
+	MaxBatchBytes int
+
+	// FlushInterval is the interval at which queued actions are flushed regardless of
+	// count and size. The default is 60 seconds; a negative value disables it.
+	FlushInterval time.Duration
+
+	// Concurrency is the maximum number of batches sent in parallel. The default is 1.
+	Concurrency int
+
+	// MaxRetries is the number of times a document that failed with a retriable status
+	// (409, 422 or 503) is sent again. The default is 3; a negative value disables retries.
+	MaxRetries int
+
+	// RetryDelay is the initial delay before a failed document is retried. It doubles with
+	// every attempt up to MaxRetryDelay. The defaults are 800 milliseconds and 1 minute.
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+
+	// OnActionFailed is called for every action that failed permanently, either with the
+	// IndexingResult reported by the service or with the error of the whole request.
+	// It may be called concurrently from multiple goroutines.
+	OnActionFailed func(action *searchindex.IndexAction, result *searchindex.IndexingResult, err error)
+}
+
+// BatchSender queues index actions and sends them to the index in batches. Batches are
+// flushed when they reach a configured number of actions or payload size, at a
+// regular interval, and on Flush and Close. Documents that fail with a transient status
+// are retried with exponential backoff; permanent failures are reported through
+// BatchSenderOptions.OnActionFailed.
+type BatchSender struct {
+	docs     *searchindex.DocumentsClient
+	keyField string
+	opts     BatchSenderOptions
+
+	mu           sync.Mutex
+	pending      []*queuedAction
+	pendingBytes int
+	closed       bool
+	inflight     int
+	idle         chan struct{} // closed whenever inflight is zero
+
+	sem    chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	stop   chan struct{}
+	ticker chan struct{} // closed when the interval flush goroutine has exited
+}
+
+type queuedAction struct {
+	action   *searchindex.IndexAction
+	key      string
+	size     int
+	attempts int
+}
+
+// NewBatchSender creates a new instance of BatchSender with the specified values.
+//   - docs - the DocumentsClient of the index, e.g. from Client.Documents
+//   - keyField - the name of the index's key field, used to match results to actions
+//   - options - BatchSenderOptions contains the optional parameters, pass nil to accept the default values.
+func NewBatchSender(docs *searchindex.DocumentsClient, keyField string, options *BatchSenderOptions) (*BatchSender, error) {
+	if keyField == "" {
+		return nil, errors.New("parameter keyField cannot be empty")
+	}
+	opts := BatchSenderOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.MaxBatchActions <= 0 || opts.MaxBatchActions > searchindex.MaxIndexBatchActions {
+		opts.MaxBatchActions = searchindex.MaxIndexBatchActions
+	}
+	if opts.MaxBatchBytes <= 0 {
+		opts.MaxBatchBytes = 15 << 20
+	}
+	if opts.FlushInterval == 0 {
+		opts.FlushInterval = time.Minute
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	if opts.MaxRetries == 0 {
+		opts.MaxRetries = searchindex.DefaultIndexMaxRetries
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = searchindex.DefaultIndexRetryDelay
+	}
+	if opts.MaxRetryDelay <= 0 {
+		opts.MaxRetryDelay = time.Minute
+	}
+
+	idle := make(chan struct{})
+	close(idle)
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &BatchSender{
+		docs:     docs,
+		keyField: keyField,
+		opts:     opts,
+		idle:     idle,
+		sem:      make(chan struct{}, opts.Concurrency),
+		ctx:      ctx,
+		cancel:   cancel,
+		stop:     make(chan struct{}),
+		ticker:   make(chan struct{}),
+	}
+	go s.flushOnInterval()
+	return s, nil
+}
+
+// Add queues actions. If the queue reaches MaxBatchActions or MaxBatchBytes, full batches
+// are sent; Add blocks while all Concurrency slots are busy, until ctx is done. Once the
+// actions are queued, Add returns nil even if ctx is done while it waits: they stay queued
+// and are sent by a later Add, Flush or Close, so callers must not add them again. If ctx
+// is done before the call, Add returns ctx.Err() and doesn't queue anything.
+func (s *BatchSender) Add(ctx context.Context, actions ...*searchindex.IndexAction) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	queued := make([]*queuedAction, 0, len(actions))
+	for i, a := range actions {
+		if a == nil {
+			continue
+		}
+		key, ok := a.AdditionalProperties[s.keyField].(string)
+		if !ok || key == "" {
+			return fmt.Errorf("action %d: key field %q is missing or not a string", i, s.keyField)
+		}
+		b, err := json.Marshal(a)
+		if err != nil {
+			return fmt.Errorf("action %d: %w", i, err)
+		}
+		queued = append(queued, &queuedAction{action: a, key: key, size: len(b)})
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrBatchSenderClosed
+	}
+	s.enqueueLocked(queued)
+	s.mu.Unlock()
+
+	// if ctx is done while waiting for a slot, the remaining batches stay queued
+	_ = s.dispatch(ctx, false)
+	return nil
+}
+
+// Flush sends all queued actions and waits until they, including retries, have completed.
+func (s *BatchSender) Flush(ctx context.Context) error {
+	for {
+		if err := s.dispatch(ctx, true); err != nil {
+			return err
+		}
+		if err := s.waitIdle(ctx); err != nil {
+			return err
+		}
+		s.mu.Lock()
+		empty := len(s.pending) == 0
+		s.mu.Unlock()
+		if empty {
+			return nil
+		}
+	}
+}
+
+// Close stops accepting actions, sends everything still queued and waits for it to
+// complete. If ctx is done first, in-flight requests are cancelled, every action that
+// wasn't delivered is reported to OnActionFailed, and ctx.Err() is returned.
+func (s *BatchSender) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.mu.Unlock()
+
+	close(s.stop)
+	<-s.ticker
+
+	err := s.Flush(ctx)
+	s.cancel()
+	if err == nil {
+		return nil
+	}
+	// cancelled requests and retries report their actions; whatever they leave queued is reported here
+	_ = s.waitIdle(context.Background())
+	s.mu.Lock()
+	pending := s.pending
+	s.pending, s.pendingBytes = nil, 0
+	s.mu.Unlock()
+	for _, qa := range pending {
+		s.fail(qa, nil, err)
+	}
+	return err
+}
+
+func (s *BatchSender) flushOnInterval() {
+	defer close(s.ticker)
+	if s.opts.FlushInterval < 0 {
+		<-s.stop
+		return
+	}
+	t := time.NewTicker(s.opts.FlushInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			_ = s.dispatch(s.ctx, true)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *BatchSender) enqueueLocked(queued []*queuedAction) {
+	for _, qa := range queued {
+		s.pending = append(s.pending, qa)
+		s.pendingBytes += qa.size
+	}
+}
+
+// dispatch sends queued actions in batches. Unless all is set, only full batches are sent.
+func (s *BatchSender) dispatch(ctx context.Context, all bool) error {
+	for {
+		batch := s.takeBatch(all)
+		if batch == nil {
+			return nil
+		}
+		select {
+		case s.sem <- struct{}{}:
+		case <-ctx.Done():
+			s.mu.Lock()
+			s.pending = append(batch, s.pending...)
+			for _, qa := range batch {
+				s.pendingBytes += qa.size
+			}
+			s.mu.Unlock()
+			return ctx.Err()
+		}
+		s.begin()
+		go func() {
+			defer s.end()
+			s.send(batch)
+		}()
+	}
+}
+
+// takeBatch removes the next batch from the queue, or returns nil if there is none.
+func (s *BatchSender) takeBatch(all bool) []*queuedAction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.pending) == 0 {
+		return nil
+	}
+	if !all && len(s.pending) < s.opts.MaxBatchActions && s.pendingBytes < s.opts.MaxBatchBytes {
+		return nil
+	}
+	n, size := 0, 0
+	for n < len(s.pending) && n < s.opts.MaxBatchActions {
+		if n > 0 && size+s.pending[n].size > s.opts.MaxBatchBytes {
+			break
+		}
+		size += s.pending[n].size
+		n++
+	}
+	batch := append([]*queuedAction{}, s.pending[:n]...)
+	s.pending = s.pending[n:]
+	s.pendingBytes -= size
+	return batch
+}
+
+func (s *BatchSender) send(batch []*queuedAction) {
+	ib := searchindex.IndexBatch{Actions: make([]*searchindex.IndexAction, len(batch))}
+	for i, qa := range batch {
+		ib.Actions[i] = qa.action
+	}
+	// on error, resp holds the results of the parts that were sent before the failing one
+	resp, err := s.docs.IndexWithSplitting(s.ctx, ib, nil, nil)
+	<-s.sem
+
+	byKey := make(map[string][]*queuedAction, len(batch))
+	for _, qa := range batch {
+		byKey[qa.key] = append(byKey[qa.key], qa)
+	}
+	var retry []*queuedAction
+	for _, r := range resp.Results {
+		if r == nil || r.Key == nil || len(byKey[*r.Key]) == 0 {
+			continue
+		}
+		qa := byKey[*r.Key][0]
+		byKey[*r.Key] = byKey[*r.Key][1:]
+		switch {
+		case r.Succeeded != nil && *r.Succeeded:
+		case r.StatusCode != nil && searchindex.IsRetriableIndexingStatus(*r.StatusCode) && qa.attempts < s.opts.MaxRetries:
+			qa.attempts++
+			retry = append(retry, qa)
+		default:
+			s.fail(qa, r, nil)
+		}
+	}
+	for _, unmatched := range byKey {
+		for _, qa := range unmatched {
+			if err != nil {
+				s.fail(qa, nil, err)
+			} else {
+				s.fail(qa, nil, fmt.Errorf("no indexing result returned for key %q", qa.key))
+			}
+		}
+	}
+	if len(retry) > 0 {
+		s.scheduleRetry(retry)
+	}
+}
+
+// scheduleRetry requeues actions after a backoff based on their attempt count.
+func (s *BatchSender) scheduleRetry(retry []*queuedAction) {
+	attempts := 0
+	for _, qa := range retry {
+		attempts = max(attempts, qa.attempts)
+	}
+	delay := s.opts.RetryDelay << (attempts - 1)
+	if delay <= 0 || delay > s.opts.MaxRetryDelay {
+		delay = s.opts.MaxRetryDelay
+	}
+
+	s.begin()
+	go func() {
+		defer s.end()
+		t := time.NewTimer(delay)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-s.ctx.Done():
+			for _, qa := range retry {
+				s.fail(qa, nil, s.ctx.Err())
+			}
+			return
+		}
+		s.mu.Lock()
+		s.enqueueLocked(retry)
+		s.mu.Unlock()
+		_ = s.dispatch(s.ctx, true)
+	}()
+}
+
+func (s *BatchSender) fail(qa *queuedAction, result *searchindex.IndexingResult, err error) {
+	if s.opts.OnActionFailed != nil {
+		s.opts.OnActionFailed(qa.action, result, err)
+	}
+}
+
+func (s *BatchSender) begin() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inflight == 0 {
+		s.idle = make(chan struct{})
+	}
+	s.inflight++
+}
+
+func (s *BatchSender) end() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inflight--
+	if s.inflight == 0 {
+		close(s.idle)
+	}
+}
+
+// waitIdle waits until no batches are in flight and no retries are scheduled.
+func (s *BatchSender) waitIdle(ctx context.Context) error {
+	for {
+		s.mu.Lock()
+		if s.inflight == 0 {
+			s.mu.Unlock()
+			return nil
+		}
+		idle := s.idle
+		s.mu.Unlock()
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package azaisearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+// blockingTransport holds every request until its context is done.
+type blockingTransport struct{}
+
+func (blockingTransport) Do(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestBatchSenderCloseReportsUndelivered(t *testing.T) {
+	docs, err := NewDocumentsClientWithSharedKey("https://test.search.windows.net", "hotels", azcore.NewKeyCredential("key"), &DocumentClientOptions{
+		ClientOptions: azcore.ClientOptions{Transport: blockingTransport{}, Retry: policy.RetryOptions{MaxRetries: -1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mu sync.Mutex
+	var failed []string
+	sender, err := NewBatchSender(docs, "id", &BatchSenderOptions{
+		MaxBatchActions: 1,
+		FlushInterval:   -1,
+		MaxRetries:      -1,
+		OnActionFailed: func(action *searchindex.IndexAction, _ *searchindex.IndexingResult, err error) {
+			if err == nil {
+				t.Errorf("OnActionFailed(%v) error = nil", action.AdditionalProperties["id"])
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			failed = append(failed, action.AdditionalProperties["id"].(string))
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	upload := searchindex.IndexActionTypeUpload
+	action := func(id string) *searchindex.IndexAction {
+		return &searchindex.IndexAction{ActionType: &upload, AdditionalProperties: map[string]any{"id": id}}
+	}
+
+	// the first batch occupies the only slot, so the others stay queued
+	if err := sender.Add(context.Background(), action("1")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	// Add gives up waiting for the busy slot, but the actions stay queued
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := sender.Add(ctx, action("2"), action("3")); err != nil {
+		t.Fatalf("Add() with a busy slot error = %v", err)
+	}
+	if err := sender.Add(ctx, action("4")); err != context.DeadlineExceeded {
+		t.Fatalf("Add() with a done context error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := sender.Close(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Close() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	sort.Strings(failed)
+	if want := []string{"1", "2", "3"}; !slices.Equal(failed, want) {
+		t.Errorf("failed actions = %v, want %v", failed, want)
+	}
+}
+
+func TestBatchSenderPartialFailure(t *testing.T) {
+	// batches of more than two actions are too large, and the batch with key 3 fails
+	docs := newTestDocumentsClient(t, transportFunc(func(req *http.Request) (*http.Response, error) {
+		var batch searchindex.IndexBatch
+		if err := json.NewDecoder(req.Body).Decode(&batch); err != nil {
+			return nil, err
+		}
+		if len(batch.Actions) > 2 {
+			return jsonResponse(req, http.StatusRequestEntityTooLarge, `{"error":{"code":"RequestEntityTooLarge","message":"too large"}}`), nil
+		}
+		var results []string
+		for _, a := range batch.Actions {
+			key := a.AdditionalProperties["id"].(string)
+			if key == "3" {
+				return jsonResponse(req, http.StatusInternalServerError, `{"error":{"code":"InternalError","message":"failed"}}`), nil
+			}
+			results = append(results, fmt.Sprintf(`{"key":%q,"status":true,"statusCode":201}`, key))
+		}
+		return jsonResponse(req, http.StatusOK, `{"value":[`+strings.Join(results, ",")+`]}`), nil
+	}))
+	var mu sync.Mutex
+	var failed []string
+	sender, err := NewBatchSender(docs, "id", &BatchSenderOptions{
+		MaxBatchActions: 5000,
+		FlushInterval:   -1,
+		OnActionFailed: func(action *searchindex.IndexAction, _ *searchindex.IndexingResult, err error) {
+			if err == nil {
+				t.Errorf("OnActionFailed(%v) error = nil", action.AdditionalProperties["id"])
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			failed = append(failed, action.AdditionalProperties["id"].(string))
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sender.opts.MaxBatchActions != searchindex.MaxIndexBatchActions {
+		t.Errorf("MaxBatchActions = %d, want %d", sender.opts.MaxBatchActions, searchindex.MaxIndexBatchActions)
+	}
+	upload := searchindex.IndexActionTypeUpload
+	for _, id := range []string{"1", "2", "3", "4"} {
+		if err := sender.Add(context.Background(), &searchindex.IndexAction{ActionType: &upload, AdditionalProperties: map[string]any{"id": id}}); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	if err := sender.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	sort.Strings(failed)
+	if want := []string{"3", "4"}; !slices.Equal(failed, want) {
+		t.Errorf("failed actions = %v, want %v", failed, want)
+	}
+}
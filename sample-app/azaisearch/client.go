@@ -0,0 +1,125 @@
+package azaisearch
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+type ClientOptions struct {
+	azcore.ClientOptions
+
+	// Audience overrides the token audience derived from ClientOptions.Cloud, e.g. for
+	// sovereign or private clouds. Setting it also disables endpoint validation.
+	Audience string
+}
+
+// Client is the root client for an Azure AI Search service. It owns a single
+// pipeline (transport, retry and auth policies) that is shared by all sub-clients
+// it hands out, so document clients for many indexes reuse the same connection
+// pool and token cache.
+type Client struct {
+	endpoint string
+	internal *azcore.Client
+}
+
+// NewClient creates a new instance of Client with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - client options, pass nil to accept the default values.
+func NewClient(endpoint string, cred azcore.TokenCredential, options *ClientOptions) (*Client, error) {
+	if options == nil {
+		options = &ClientOptions{}
+	}
+
+	authPolicy, err := newBearerTokenPolicy(endpoint, cred, options.Cloud, options.Audience)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(endpoint, authPolicy, options)
+}
+
+// NewClientWithSharedKey creates a new instance of Client with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - keyCred - used to authorize requests with a shared key
+//   - options - client options, pass nil to accept the default values.
+func NewClientWithSharedKey(endpoint string, keyCred *azcore.KeyCredential, options *ClientOptions) (*Client, error) {
+
+	authPolicy := runtime.NewKeyCredentialPolicy(keyCred, "api-key", &runtime.KeyCredentialPolicyOptions{})
+	return newClient(endpoint, authPolicy, options)
+}
+
+func newClient(endpoint string, authPolicy policy.Policy, options *ClientOptions) (*Client, error) {
+	if options == nil {
+		options = &ClientOptions{}
+	}
+
+	c, err := newCoreClient(authPolicy, &options.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{endpoint: endpoint, internal: c}, nil
+}
+
+// newCoreClient creates the azcore.Client that every client in this package is built on.
+func newCoreClient(authPolicy policy.Policy, options *azcore.ClientOptions) (*azcore.Client, error) {
+	return azcore.NewClient(moduleName, moduleVersion, runtime.PipelineOptions{
+		PerRetry: []policy.Policy{authPolicy},
+	}, options)
+}
+
+// Endpoint returns the endpoint of the Azure AI Search service.
+func (c *Client) Endpoint() string {
+	return c.endpoint
+}
+
+// The generated constructors used below only copy their arguments and never
+// return an error, so the sub-client accessors do not surface one either.
+
+// Indexes returns an IndexesClient that shares this client's pipeline.
+func (c *Client) Indexes() *searchservice.IndexesClient {
+	client, _ := searchservice.NewIndexesClient(c.endpoint, c.internal)
+	return client
+}
+
+// Indexers returns an IndexersClient that shares this client's pipeline.
+func (c *Client) Indexers() *searchservice.IndexersClient {
+	client, _ := searchservice.NewIndexersClient(c.endpoint, c.internal)
+	return client
+}
+
+// DataSources returns a DataSourcesClient that shares this client's pipeline.
+func (c *Client) DataSources() *searchservice.DataSourcesClient {
+	client, _ := searchservice.NewDataSourcesClient(c.endpoint, c.internal)
+	return client
+}
+
+// Skillsets returns a SkillsetsClient that shares this client's pipeline.
+func (c *Client) Skillsets() *searchservice.SkillsetsClient {
+	client, _ := searchservice.NewSkillsetsClient(c.endpoint, c.internal)
+	return client
+}
+
+// SynonymMaps returns a SynonymMapsClient that shares this client's pipeline.
+func (c *Client) SynonymMaps() *searchservice.SynonymMapsClient {
+	client, _ := searchservice.NewSynonymMapsClient(c.endpoint, c.internal)
+	return client
+}
+
+// Service returns a SearchClient for service level operations (e.g. statistics)
+// that shares this client's pipeline.
+func (c *Client) Service() *searchservice.SearchClient {
+	client, _ := searchservice.NewSearchClient(c.endpoint, c.internal)
+	return client
+}
+
+// Documents returns a DocumentsClient for the given index that shares this client's pipeline.
+//   - indexName - the name of the index to manage documents
+func (c *Client) Documents(indexName string) *searchindex.DocumentsClient {
+	client, _ := searchindex.NewDocumentsClient(c.endpoint, indexName, c.internal)
+	return client
+}
@@ -0,0 +1,93 @@
+package azaisearch
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"sample-app/azaisearch/internal"
+)
+
+// ServiceName is the cloud.ServiceName for Azure AI Search. Custom cloud configurations can add
+// an entry for it to cloud.Configuration.Services, with the token audience and, in the Endpoint
+// field, the DNS suffix that service endpoints of that cloud end with. The Azure public, China
+// and government clouds don't need one.
+const ServiceName cloud.ServiceName = "azaisearch"
+
+// knownClouds holds the Azure AI Search configuration of the Azure clouds by their
+// ActiveDirectoryAuthorityHost. It is kept here rather than added to the azcore cloud
+// configurations, which are shared by every package that imports them.
+var knownClouds = map[string]cloud.ServiceConfiguration{
+	cloud.AzurePublic.ActiveDirectoryAuthorityHost: {
+		Audience: internal.AudiencePublic,
+		Endpoint: internal.EndpointSuffixPublic,
+	},
+	cloud.AzureChina.ActiveDirectoryAuthorityHost: {
+		Audience: internal.AudienceChina,
+		Endpoint: internal.EndpointSuffixChina,
+	},
+	cloud.AzureGovernment.ActiveDirectoryAuthorityHost: {
+		Audience: internal.AudienceGovernment,
+		Endpoint: internal.EndpointSuffixGovernment,
+	},
+}
+
+// newBearerTokenPolicy creates the token auth policy for the given cloud configuration, see tokenScope.
+func newBearerTokenPolicy(endpoint string, cred azcore.TokenCredential, cfg cloud.Configuration, audience string) (policy.Policy, error) {
+	scope, err := tokenScope(endpoint, cfg, audience)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.NewBearerTokenPolicy(cred, []string{scope}, &policy.BearerTokenOptions{}), nil
+}
+
+// tokenScope returns the token scope for endpoint. An explicit audience takes precedence over the
+// cloud configuration and disables endpoint validation, so custom domains and private clouds keep
+// working. Otherwise the ServiceName entry of cfg is used, or the configuration of the known cloud
+// with the ActiveDirectoryAuthorityHost of cfg. An empty cfg is the Azure public cloud.
+func tokenScope(endpoint string, cfg cloud.Configuration, audience string) (string, error) {
+	if audience == "" {
+		svc, ok := cfg.Services[ServiceName]
+		if !ok {
+			host := cfg.ActiveDirectoryAuthorityHost
+			if host == "" && len(cfg.Services) == 0 {
+				host = cloud.AzurePublic.ActiveDirectoryAuthorityHost
+			}
+			svc, ok = knownClouds[host]
+		}
+		if !ok || svc.Audience == "" {
+			return "", fmt.Errorf("cloud configuration has no audience for service %q; set the Audience option", ServiceName)
+		}
+		if err := validateEndpoint(endpoint, svc.Endpoint); err != nil {
+			return "", err
+		}
+		audience = svc.Audience
+	}
+	return strings.TrimSuffix(audience, "/") + "/.default", nil
+}
+
+// validateEndpoint checks that endpoint is an https URL without a path whose host ends with suffix.
+// An empty suffix skips validation.
+func validateEndpoint(endpoint string, suffix string) error {
+	if suffix == "" {
+		return nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	switch {
+	case u.Scheme != "https":
+		return fmt.Errorf("invalid endpoint %q: the scheme must be https", endpoint)
+	case strings.Trim(u.Path, "/") != "" || u.RawQuery != "" || u.Fragment != "":
+		return fmt.Errorf("invalid endpoint %q: it must not have a path, query or fragment", endpoint)
+	case !strings.HasSuffix(strings.ToLower(u.Hostname()), strings.ToLower(suffix)):
+		return fmt.Errorf("endpoint %q does not match the configured cloud (expected host ending in %q); set the Cloud or Audience option", endpoint, suffix)
+	}
+	return nil
+}
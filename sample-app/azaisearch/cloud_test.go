@@ -0,0 +1,89 @@
+package azaisearch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+)
+
+func TestTokenScope(t *testing.T) {
+	custom := cloud.Configuration{
+		ActiveDirectoryAuthorityHost: "https://login.example.com/",
+		Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+			ServiceName: {Audience: "https://search.example.com/", Endpoint: ".search.example.com"},
+		},
+	}
+	tests := []struct {
+		name     string
+		endpoint string
+		cfg      cloud.Configuration
+		audience string
+		want     string
+		wantErr  string
+	}{
+		{"default cloud", "https://a.search.windows.net", cloud.Configuration{}, "", "https://search.azure.com/.default", ""},
+		{"public", "https://a.search.windows.net", cloud.AzurePublic, "", "https://search.azure.com/.default", ""},
+		{"china", "https://a.search.azure.cn", cloud.AzureChina, "", "https://search.azure.cn/.default", ""},
+		{"government", "https://a.search.azure.us", cloud.AzureGovernment, "", "https://search.azure.us/.default", ""},
+		{"custom cloud", "https://a.search.example.com", custom, "", "https://search.example.com/.default", ""},
+		{"explicit audience", "https://search.contoso.com", cloud.AzurePublic, "https://search.azure.com/", "https://search.azure.com/.default", ""},
+		{"wrong cloud", "https://a.search.windows.net", cloud.AzureChina, "", "", "does not match the configured cloud"},
+		{"unknown cloud", "https://a.search.windows.net", cloud.Configuration{ActiveDirectoryAuthorityHost: "https://login.example.com/"}, "", "", "set the Audience option"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tokenScope(tt.endpoint, tt.cfg, tt.audience)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("tokenScope() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("tokenScope() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("tokenScope() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if _, ok := cloud.AzurePublic.Services[ServiceName]; ok {
+		t.Error("cloud.AzurePublic has an entry for ServiceName")
+	}
+}
+
+func TestValidateEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		suffix   string
+		wantErr  string
+	}{
+		{"public", "https://a.search.windows.net", ".search.windows.net", ""},
+		{"trailing slash", "https://a.search.windows.net/", ".search.windows.net", ""},
+		{"upper case", "https://A.Search.Windows.Net", ".search.windows.net", ""},
+		{"port", "https://a.search.windows.net:443", ".search.windows.net", ""},
+		{"china", "https://a.search.azure.cn", ".search.azure.cn", ""},
+		{"government", "https://a.search.azure.us", ".search.azure.us", ""},
+		{"no suffix", "http://localhost:8080/x", "", ""},
+		{"http", "http://a.search.windows.net", ".search.windows.net", "the scheme must be https"},
+		{"path", "https://a.search.windows.net/indexes", ".search.windows.net", "must not have a path"},
+		{"query", "https://a.search.windows.net?api-version=1", ".search.windows.net", "must not have a path"},
+		{"public endpoint for china", "https://a.search.windows.net", ".search.azure.cn", "does not match the configured cloud"},
+		{"china endpoint for public", "https://a.search.azure.cn", ".search.windows.net", "does not match the configured cloud"},
+		{"suffix in path", "https://evil.example.com/.search.windows.net", ".search.windows.net", "must not have a path"},
+		{"invalid URL", "https://a b\x7f", ".search.windows.net", "invalid endpoint"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEndpoint(tt.endpoint, tt.suffix)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("validateEndpoint() error = %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("validateEndpoint() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/build"
+	"go/format"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"sample-app/azaisearch/edm"
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// config contains the settings of a generation run.
+type config struct {
+	pkg        string
+	typeName   string
+	pointers   bool
+	searchTags bool
+	command    string
+}
+
+// structDecl is a generated struct type.
+type structDecl struct {
+	name   string
+	doc    string
+	fields []fieldDecl
+}
+
+type fieldDecl struct {
+	name string
+	typ  string
+	tag  string
+}
+
+// generator collects the struct types and imports of the generated file.
+type generator struct {
+	config
+	structs []*structDecl
+	names   map[string]bool
+	imports map[string]bool
+}
+
+// generate returns the formatted Go source for the document types of index.
+func generate(index *searchservice.SearchIndex, cfg config) ([]byte, error) {
+	if index.Name == nil {
+		return nil, fmt.Errorf("index definition has no name")
+	}
+	if cfg.typeName == "" {
+		cfg.typeName = goName(*index.Name)
+	}
+	g := &generator{config: cfg, names: map[string]bool{}, imports: map[string]bool{}}
+	g.names[cfg.typeName] = true
+	doc := fmt.Sprintf("%s is a document of the index %q.", cfg.typeName, *index.Name)
+	if err := g.addStruct(cfg.typeName, doc, index.Fields, true); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by %s. DO NOT EDIT.\n\n", cfg.command)
+	fmt.Fprintf(&buf, "package %s\n\n", cfg.pkg)
+	if len(g.imports) > 0 {
+		// standard library imports first, like goimports
+		var std, other []string
+		for p := range g.imports {
+			if pkg, err := build.Import(p, "", build.FindOnly); err == nil && pkg.Goroot {
+				std = append(std, p)
+			} else {
+				other = append(other, p)
+			}
+		}
+		sort.Strings(std)
+		sort.Strings(other)
+		buf.WriteString("import (\n")
+		for i, group := range [][]string{std, other} {
+			if i > 0 && len(std) > 0 && len(other) > 0 {
+				buf.WriteString("\n")
+			}
+			for _, p := range group {
+				fmt.Fprintf(&buf, "\t%q\n", p)
+			}
+		}
+		buf.WriteString(")\n\n")
+	}
+	for _, s := range g.structs {
+		fmt.Fprintf(&buf, "// %s\n", s.doc)
+		fmt.Fprintf(&buf, "type %s struct {\n", s.name)
+		for _, f := range s.fields {
+			fmt.Fprintf(&buf, "\t%s %s `%s`\n", f.name, f.typ, f.tag)
+		}
+		buf.WriteString("}\n\n")
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w", err)
+	}
+	return src, nil
+}
+
+// addStruct adds the struct type name for fields. Complex subfields are added after it, depth first.
+func (g *generator) addStruct(name, doc string, fields []*searchservice.SearchField, root bool) error {
+	s := &structDecl{name: name, doc: doc}
+	g.structs = append(g.structs, s)
+	used := map[string]bool{}
+	for _, f := range fields {
+		if f == nil || f.Name == nil || f.Type == nil {
+			return fmt.Errorf("type %s: field without name or type", name)
+		}
+		fieldName := unique(goName(*f.Name), used)
+		typ, override, err := g.goType(f, name+fieldName)
+		if err != nil {
+			return fmt.Errorf("field %q: %w", *f.Name, err)
+		}
+		_, collection := schema.ElementType(*f.Type)
+		key := root && schema.Attribute(f.Key, false)
+		nullable := !collection && !key
+		if nullable && (g.pointers || schema.IsComplex(f)) {
+			typ = "*" + typ
+		}
+
+		tag := `json:"` + *f.Name
+		if nullable && strings.HasPrefix(typ, "*") || collection {
+			tag += ",omitempty"
+		}
+		tag += `"`
+		if g.searchTags {
+			if opts := searchTag(f, override); opts != "" {
+				tag += ` search:"` + opts + `"`
+			}
+		}
+		s.fields = append(s.fields, fieldDecl{name: fieldName, typ: typ, tag: tag})
+	}
+	return nil
+}
+
+// goType returns the Go type of field f. For types that azaisearch.FieldsFor wouldn't derive from
+// that Go type, override is the Edm type to put in the search tag. Complex fields are added as
+// struct types named complexName.
+func (g *generator) goType(f *searchservice.SearchField, complexName string) (typ string, override bool, err error) {
+	elem, collection := schema.ElementType(*f.Type)
+	prefix := ""
+	if collection {
+		prefix = "[]"
+	}
+	switch elem {
+	case searchservice.SearchFieldDataTypeComplex:
+		name := complexName
+		for g.names[name] {
+			name += "_"
+		}
+		g.names[name] = true
+		doc := fmt.Sprintf("%s is the value of the complex field %q.", name, *f.Name)
+		if err := g.addStruct(name, doc, f.Fields, false); err != nil {
+			return "", false, err
+		}
+		return prefix + name, false, nil
+	case searchservice.SearchFieldDataTypeByte:
+		// encoding/json writes []uint8 as a base64 string
+		return prefix + "int16", true, nil
+	case searchservice.SearchFieldDataTypeHalf:
+		return prefix + "float32", true, nil
+	}
+
+	t, err := edm.GoType(elem)
+	if err != nil {
+		return "", false, err
+	}
+	if t.PkgPath() != "" {
+		g.imports[t.PkgPath()] = true
+	}
+	// FieldsFor derives Collection(Edm.Single), Collection(Edm.Int16) and Collection(Edm.SByte) only for
+	// vector fields; scalar Edm.Single, Edm.Int16 and Edm.SByte don't exist
+	vector := collection && f.VectorSearchDimensions != nil
+	override = !vector && (t.Kind() == reflect.Float32 || t.Kind() == reflect.Int16 || t.Kind() == reflect.Int8)
+	return prefix + t.String(), override, nil
+}
+
+// searchTag returns the search tag options for the attributes of f. Unset attributes are left out.
+func searchTag(f *searchservice.SearchField, override bool) string {
+	if schema.IsComplex(f) {
+		return ""
+	}
+	var opts []string
+	if override {
+		opts = append(opts, "type="+string(*f.Type))
+	}
+	if schema.Attribute(f.Key, false) {
+		opts = append(opts, "key")
+	}
+	vector := f.VectorSearchDimensions != nil
+	for _, a := range []struct {
+		name  string
+		value *bool
+	}{
+		{"searchable", f.Searchable},
+		{"filterable", f.Filterable},
+		{"sortable", f.Sortable},
+		{"facetable", f.Facetable},
+	} {
+		switch {
+		case a.value == nil, vector && a.name == "searchable" && *a.value:
+		case *a.value:
+			opts = append(opts, a.name)
+		default:
+			opts = append(opts, a.name+"=false")
+		}
+	}
+	if f.Retrievable != nil && !*f.Retrievable {
+		opts = append(opts, "hidden")
+	}
+	if f.Stored != nil && !*f.Stored {
+		opts = append(opts, "stored=false")
+	}
+	if f.Analyzer != nil {
+		opts = append(opts, "analyzer="+string(*f.Analyzer))
+	}
+	if f.SearchAnalyzer != nil {
+		opts = append(opts, "searchAnalyzer="+string(*f.SearchAnalyzer))
+	}
+	if f.IndexAnalyzer != nil {
+		opts = append(opts, "indexAnalyzer="+string(*f.IndexAnalyzer))
+	}
+	if f.Normalizer != nil {
+		opts = append(opts, "normalizer="+string(*f.Normalizer))
+	}
+	if len(f.SynonymMaps) > 0 {
+		var maps []string
+		for _, m := range f.SynonymMaps {
+			if m != nil {
+				maps = append(maps, *m)
+			}
+		}
+		opts = append(opts, "synonymMaps="+strings.Join(maps, "|"))
+	}
+	if vector {
+		opts = append(opts, "vector="+strconv.Itoa(int(*f.VectorSearchDimensions)))
+	}
+	if f.VectorSearchProfileName != nil {
+		opts = append(opts, "profile="+*f.VectorSearchProfileName)
+	}
+	return strings.Join(opts, ",")
+}
+
+// commonInitialisms are the words written in upper case in Go names.
+var commonInitialisms = map[string]bool{
+	"API": true, "HTML": true, "HTTP": true, "HTTPS": true, "ID": true, "IP": true,
+	"JSON": true, "SQL": true, "URI": true, "URL": true, "UUID": true, "XML": true,
+}
+
+// goName returns an exported Go identifier for an index or field name, e.g. HotelID for hotelId
+// and ParkingIncluded for parking_included.
+func goName(s string) string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = word[:0]
+		}
+	}
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) ||
+			unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])):
+			flush()
+		}
+		word = append(word, r)
+	}
+	flush()
+
+	var b strings.Builder
+	for _, w := range words {
+		if upper := strings.ToUpper(w); commonInitialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		r := []rune(w)
+		b.WriteString(string(unicode.ToUpper(r[0])) + string(r[1:]))
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+// unique returns name, or name with a numeric suffix if it is already used, and marks it as used.
+func unique(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = name + strconv.Itoa(i)
+	}
+	used[candidate] = true
+	return candidate
+}
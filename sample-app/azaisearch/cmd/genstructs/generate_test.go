@@ -0,0 +1,190 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestGoName(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"hotels", "Hotels"},
+		{"hotelId", "HotelID"},
+		{"parking_included", "ParkingIncluded"},
+		{"HTTPStatus", "HTTPStatus"},
+		{"imageUrl", "ImageURL"},
+		{"api-key", "APIKey"},
+		{"lastRenovationDate", "LastRenovationDate"},
+		{"3d_model", "X3dModel"},
+		{"_", "X"},
+		{"café", "Café"},
+	}
+	for _, tt := range tests {
+		if got := goName(tt.in); got != tt.want {
+			t.Errorf("goName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	used := map[string]bool{}
+	var got []string
+	for _, name := range []string{"ID", "Name", "ID", "ID", "Name"} {
+		got = append(got, unique(name, used))
+	}
+	if want := "ID Name ID2 ID3 Name2"; strings.Join(got, " ") != want {
+		t.Errorf("unique() = %q, want %q", strings.Join(got, " "), want)
+	}
+}
+
+func TestGoType(t *testing.T) {
+	tests := []struct {
+		typ          searchservice.SearchFieldDataType
+		dims         *int32
+		want         string
+		wantOverride bool
+	}{
+		{searchservice.SearchFieldDataTypeInt32, nil, "int32", false},
+		{"Collection(Edm.Int64)", nil, "[]int64", false},
+		{"Collection(Edm.Double)", nil, "[]float64", false},
+		{"Collection(Edm.Single)", ptr(int32(3)), "[]float32", false},
+		{"Collection(Edm.Int16)", ptr(int32(3)), "[]int16", false},
+		{"Collection(Edm.SByte)", ptr(int32(3)), "[]int8", false},
+		{"Collection(Edm.Single)", nil, "[]float32", true},
+		{"Collection(Edm.Int16)", nil, "[]int16", true},
+		{"Collection(Edm.Byte)", ptr(int32(3)), "[]int16", true},
+		{"Collection(Edm.Half)", ptr(int32(3)), "[]float32", true},
+	}
+	for _, tt := range tests {
+		g := &generator{names: map[string]bool{}, imports: map[string]bool{}}
+		got, override, err := g.goType(&searchservice.SearchField{Name: ptr("f"), Type: &tt.typ, VectorSearchDimensions: tt.dims}, "F")
+		if err != nil {
+			t.Errorf("goType(%s) error = %v", tt.typ, err)
+			continue
+		}
+		if got != tt.want || override != tt.wantOverride {
+			t.Errorf("goType(%s, dims %v) = %q, %v, want %q, %v", tt.typ, tt.dims != nil, got, override, tt.want, tt.wantOverride)
+		}
+	}
+}
+
+func TestSearchTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    searchservice.SearchField
+		override bool
+		want     string
+	}{
+		{"unset attributes", searchservice.SearchField{Type: ptr(searchservice.SearchFieldDataTypeString)}, false, ""},
+		{
+			name: "key",
+			field: searchservice.SearchField{
+				Type: ptr(searchservice.SearchFieldDataTypeString), Key: ptr(true), Filterable: ptr(true), Searchable: ptr(false),
+			},
+			want: "key,searchable=false,filterable",
+		},
+		{
+			name: "analyzers and synonyms",
+			field: searchservice.SearchField{
+				Type:           ptr(searchservice.SearchFieldDataTypeString),
+				Searchable:     ptr(true),
+				Retrievable:    ptr(false),
+				Stored:         ptr(false),
+				SearchAnalyzer: ptr(searchservice.LexicalAnalyzerName("standard.lucene")),
+				IndexAnalyzer:  ptr(searchservice.LexicalAnalyzerName("en.microsoft")),
+				SynonymMaps:    []*string{ptr("a"), ptr("b")},
+			},
+			want: "searchable,hidden,stored=false,searchAnalyzer=standard.lucene,indexAnalyzer=en.microsoft,synonymMaps=a|b",
+		},
+		{
+			name: "vector",
+			field: searchservice.SearchField{
+				Type:                    ptr(searchservice.SearchFieldDataType("Collection(Edm.Single)")),
+				Searchable:              ptr(true),
+				VectorSearchDimensions:  ptr(int32(1536)),
+				VectorSearchProfileName: ptr("hnsw"),
+			},
+			want: "vector=1536,profile=hnsw",
+		},
+		{
+			name:     "type override",
+			field:    searchservice.SearchField{Type: ptr(searchservice.SearchFieldDataType("Collection(Edm.Half)")), Normalizer: ptr(searchservice.LexicalNormalizerName("lowercase"))},
+			override: true,
+			want:     "type=Collection(Edm.Half),normalizer=lowercase",
+		},
+		{"complex", searchservice.SearchField{Type: ptr(searchservice.SearchFieldDataTypeComplex), Filterable: ptr(true)}, false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := searchTag(&tt.field, tt.override); got != tt.want {
+				t.Errorf("searchTag() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	field := func(name string, typ searchservice.SearchFieldDataType, subfields ...*searchservice.SearchField) *searchservice.SearchField {
+		return &searchservice.SearchField{Name: &name, Type: &typ, Fields: subfields}
+	}
+	key := field("hotelId", searchservice.SearchFieldDataTypeString)
+	key.Key = ptr(true)
+	index := &searchservice.SearchIndex{
+		Name: ptr("hotels"),
+		Fields: []*searchservice.SearchField{
+			key,
+			field("rating", searchservice.SearchFieldDataTypeDouble),
+			field("updated", searchservice.SearchFieldDataTypeDateTimeOffset),
+			field("tags", "Collection(Edm.String)"),
+			field("address", searchservice.SearchFieldDataTypeComplex, field("city", searchservice.SearchFieldDataTypeString)),
+			field("rooms", "Collection(Edm.ComplexType)", field("beds", searchservice.SearchFieldDataTypeInt32)),
+		},
+	}
+	src, err := generate(index, config{pkg: "models", searchTags: true, command: "genstructs -index=hotels"})
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	// backticks are written as ' in want
+	want := strings.ReplaceAll(`// Code generated by genstructs -index=hotels. DO NOT EDIT.
+
+package models
+
+import (
+	"time"
+)
+
+// Hotels is a document of the index "hotels".
+type Hotels struct {
+	HotelID string         'json:"hotelId" search:"key"'
+	Rating  float64        'json:"rating"'
+	Updated time.Time      'json:"updated"'
+	Tags    []string       'json:"tags,omitempty"'
+	Address *HotelsAddress 'json:"address,omitempty"'
+	Rooms   []HotelsRooms  'json:"rooms,omitempty"'
+}
+
+// HotelsAddress is the value of the complex field "address".
+type HotelsAddress struct {
+	City string 'json:"city"'
+}
+
+// HotelsRooms is the value of the complex field "rooms".
+type HotelsRooms struct {
+	Beds int32 'json:"beds"'
+}
+`, "'", "`")
+	if string(src) != want {
+		t.Errorf("generate() =\n%s\nwant\n%s", src, want)
+	}
+
+	if _, err := generate(&searchservice.SearchIndex{}, config{pkg: "models"}); err == nil {
+		t.Error("generate() without index name error = nil")
+	}
+}
@@ -0,0 +1,133 @@
+// Command genstructs generates Go structs for the documents of an existing index, so typed
+// document models stay in sync with index definitions owned elsewhere.
+//
+// It fetches the index definition with IndexesClient.Get and is meant to be run through
+// go generate from the package that holds the models:
+//
+//	//go:generate go run sample-app/azaisearch/cmd/genstructs -index hotels -type Hotel -out hotel_gen.go
+//
+// The service endpoint is taken from -endpoint or AZSEARCH_ENDPOINT. Requests are authorized with
+// AZSEARCH_API_KEY if it is set, and with DefaultAzureCredential otherwise. With -definition, the
+// index definition is read from a JSON file instead, e.g. one checked in next to the models.
+//
+// Complex fields become structs named after their parent type and field (e.g. HotelAddress), and
+// collections become slices. Fields carry json tags with the index field names and, unless
+// -search-tags=false, search tags with their attributes, so azaisearch.FieldsFor reproduces the
+// index fields from the generated types.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+
+	"sample-app/azaisearch"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genstructs: ")
+
+	endpoint := flag.String("endpoint", os.Getenv("AZSEARCH_ENDPOINT"), "endpoint of the search service")
+	indexName := flag.String("index", "", "name of the index")
+	definition := flag.String("definition", "", "read the index definition from this JSON file instead of the service")
+	typeName := flag.String("type", "", "name of the document type (default: derived from the index name)")
+	pkgName := flag.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	out := flag.String("out", "", "output file (default: standard output)")
+	pointers := flag.Bool("pointers", false, "use pointer types for nullable fields other than collections and the key")
+	searchTags := flag.Bool("search-tags", true, "emit search tags with the field attributes")
+	timeout := flag.Duration("timeout", time.Minute, "timeout for fetching the index definition")
+	flag.Parse()
+
+	if *pkgName == "" {
+		log.Fatal("-package is required outside of go generate")
+	}
+	index, err := loadIndex(*endpoint, *indexName, *definition, *timeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := generate(index, config{
+		pkg:        *pkgName,
+		typeName:   *typeName,
+		pointers:   *pointers,
+		searchTags: *searchTags,
+		command:    "genstructs " + commandArgs(),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadIndex reads the index definition from the file definition, or fetches the index indexName.
+func loadIndex(endpoint, indexName, definition string, timeout time.Duration) (*searchservice.SearchIndex, error) {
+	if definition != "" {
+		b, err := os.ReadFile(definition)
+		if err != nil {
+			return nil, err
+		}
+		var index searchservice.SearchIndex
+		if err := json.Unmarshal(b, &index); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", definition, err)
+		}
+		return &index, nil
+	}
+
+	if indexName == "" {
+		return nil, fmt.Errorf("-index or -definition is required")
+	}
+	if endpoint == "" {
+		return nil, fmt.Errorf("-endpoint or AZSEARCH_ENDPOINT is required")
+	}
+	var client *azaisearch.Client
+	var err error
+	if apiKey := os.Getenv("AZSEARCH_API_KEY"); apiKey != "" {
+		client, err = azaisearch.NewClientWithSharedKey(endpoint, azcore.NewKeyCredential(apiKey), nil)
+	} else {
+		cred, credErr := azidentity.NewDefaultAzureCredential(nil)
+		if credErr != nil {
+			return nil, credErr
+		}
+		client, err = azaisearch.NewClient(endpoint, cred, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	resp, err := client.Indexes().Get(ctx, indexName, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.SearchIndex, nil
+}
+
+// commandArgs returns the flags set on the command line, for the header of the generated file.
+// Credentials are never passed as flags, so they can't end up in the output.
+func commandArgs() string {
+	var args string
+	flag.Visit(func(f *flag.Flag) {
+		if args != "" {
+			args += " "
+		}
+		args += "-" + f.Name + "=" + f.Value.String()
+	})
+	return args
+}
@@ -0,0 +1,323 @@
+package azaisearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// CopyOptions contains the optional parameters for CopyIndex.
+type CopyOptions struct {
+	// TransformIndex modifies the target index definition before it is created, e.g. to add
+	// fields or change analyzers. Its name is already set to the target index name.
+	TransformIndex func(index *searchservice.SearchIndex) error
+
+	// TransformDocument maps a source document to the target document. Returning a nil document
+	// skips it. It is called from a single goroutine. Without it, documents are copied unchanged.
+	// Numbers in doc are json.Number values, so Edm.Int64 values beyond 2^53 aren't rounded.
+	TransformDocument func(doc map[string]any) (map[string]any, error)
+
+	// Filter restricts the copied documents with an OData $filter expression.
+	Filter *string
+
+	// UseExistingIndex uploads into an existing target index instead of failing when it exists.
+	// TransformIndex is not applied to an existing index.
+	UseExistingIndex bool
+
+	// BatchSenderOptions configures the upload. Unset Concurrency defaults to 4 here. OnActionFailed is
+	// called in addition to counting the failures.
+	BatchSenderOptions *BatchSenderOptions
+
+	// VerifySamples is the number of randomly sampled documents compared between source and target
+	// after the copy. The default is 100; a negative value disables the comparison.
+	VerifySamples int
+
+	// VerifyTimeout is how long the verification waits for the target's document count to reach the
+	// number of uploaded documents, as new documents take a moment to become visible. The default is 1 minute.
+	VerifyTimeout time.Duration
+}
+
+// CopyResult contains the result of CopyIndex.
+type CopyResult struct {
+	// Read is the number of documents read from the source index.
+	Read int64
+
+	// Written is the number of documents uploaded to the target index, including failed ones.
+	Written int64
+
+	// Skipped is the number of documents TransformDocument returned nil for.
+	Skipped int64
+
+	// Failed is the number of documents that failed to upload.
+	Failed int64
+
+	// SourceCount and TargetCount are the document counts of both indexes after the copy.
+	SourceCount int64
+	TargetCount int64
+
+	// Mismatches describes the sampled documents that are missing from the target or differ from
+	// the transformed source document, by key.
+	Mismatches map[string]string
+}
+
+// Verified reports whether the target has a document for every successfully uploaded document
+// and all sampled documents match. Documents that already existed in the target are not accounted for.
+func (r CopyResult) Verified() bool {
+	return r.TargetCount >= r.Written-r.Failed && len(r.Mismatches) == 0
+}
+
+// CopyIndex copies an index, possibly between services: it reads the source definition with
+// IndexesClient.Get, creates the target index from it, streams all documents through
+// CopyOptions.TransformDocument and uploads them in parallel batches. It then verifies the copy by
+// comparing DocumentsClient.Count and a sample of documents.
+//
+// Documents are read with DocumentsClient.ScanAll, so the source key field must be filterable and
+// sortable, and fields that aren't retrievable are not copied.
+//   - source - the client of the source service
+//   - sourceIndex - the name of the index to copy
+//   - target - the client of the target service, which may be source
+//   - targetIndex - the name of the index to create
+//   - options - CopyOptions contains the optional parameters, pass nil to accept the default values.
+func CopyIndex(ctx context.Context, source *Client, sourceIndex string, target *Client, targetIndex string, options *CopyOptions) (CopyResult, error) {
+	opts := CopyOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.VerifySamples == 0 {
+		opts.VerifySamples = 100
+	}
+	if opts.VerifyTimeout <= 0 {
+		opts.VerifyTimeout = time.Minute
+	}
+	if source == target && sourceIndex == targetIndex {
+		return CopyResult{}, errors.New("source and target index are the same")
+	}
+
+	src, err := source.Indexes().Get(ctx, sourceIndex, nil, nil)
+	if err != nil {
+		return CopyResult{}, err
+	}
+	srcKey, err := schema.KeyField(&src.SearchIndex)
+	if err != nil {
+		return CopyResult{}, err
+	}
+	dst, err := createCopyTarget(ctx, target, src.SearchIndex, targetIndex, &opts)
+	if err != nil {
+		return CopyResult{}, err
+	}
+	dstKey, err := schema.KeyField(dst)
+	if err != nil {
+		return CopyResult{}, err
+	}
+
+	senderOptions := BatchSenderOptions{}
+	if opts.BatchSenderOptions != nil {
+		senderOptions = *opts.BatchSenderOptions
+	}
+	if senderOptions.Concurrency == 0 {
+		senderOptions.Concurrency = 4
+	}
+	var failed atomic.Int64
+	onFailed := senderOptions.OnActionFailed
+	senderOptions.OnActionFailed = func(action *searchindex.IndexAction, r *searchindex.IndexingResult, err error) {
+		failed.Add(1)
+		if onFailed != nil {
+			onFailed(action, r, err)
+		}
+	}
+	sender, err := NewBatchSender(target.Documents(targetIndex), *dstKey.Name, &senderOptions)
+	if err != nil {
+		return CopyResult{}, err
+	}
+
+	var result CopyResult
+	samples := &reservoir{size: max(opts.VerifySamples, 0)}
+	upload := searchindex.IndexActionTypeUpload
+	scan := source.Documents(sourceIndex).ScanAll(ctx, opts.Filter, nil, &searchindex.ScanOptions{KeyField: *srcKey.Name, UseNumber: true})
+	for doc, err := range scan {
+		if err != nil {
+			_ = sender.Close(ctx)
+			return result, err
+		}
+		result.Read++
+		out := doc.Document
+		if opts.TransformDocument != nil {
+			if out, err = opts.TransformDocument(out); err != nil {
+				_ = sender.Close(ctx)
+				return result, fmt.Errorf("transforming document %q: %w", doc.Key, err)
+			}
+			if out == nil {
+				result.Skipped++
+				continue
+			}
+		}
+		if err := sender.Add(ctx, &searchindex.IndexAction{ActionType: &upload, AdditionalProperties: out}); err != nil {
+			_ = sender.Close(ctx)
+			return result, err
+		}
+		result.Written++
+		samples.add(out)
+	}
+	err = sender.Close(ctx)
+	result.Failed = failed.Load()
+	if err != nil {
+		return result, err
+	}
+
+	if err := verifyCopy(ctx, source.Documents(sourceIndex), target.Documents(targetIndex), *dstKey.Name, samples.items, &opts, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// createCopyTarget creates the target index from the source definition, or returns the existing one.
+func createCopyTarget(ctx context.Context, target *Client, index searchservice.SearchIndex, name string, opts *CopyOptions) (*searchservice.SearchIndex, error) {
+	index.Name = &name
+	index.ETag = nil
+	if opts.TransformIndex != nil {
+		if err := opts.TransformIndex(&index); err != nil {
+			return nil, fmt.Errorf("transforming index definition: %w", err)
+		}
+	}
+	created, err := target.Indexes().Create(ctx, index, nil, nil)
+	if err == nil {
+		return &created.SearchIndex, nil
+	}
+	if !opts.UseExistingIndex || !isAlreadyExists(err) {
+		return nil, err
+	}
+	existing, err := target.Indexes().Get(ctx, name, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &existing.SearchIndex, nil
+}
+
+// verifyCopy fills the counts and mismatches of result.
+func verifyCopy(ctx context.Context, src, dst *searchindex.DocumentsClient, keyField string, samples []map[string]any, opts *CopyOptions, result *CopyResult) error {
+	count, err := src.Count(ctx, nil, nil)
+	if err != nil {
+		return err
+	}
+	result.SourceCount = countValue(count)
+
+	// wait for the uploaded documents to become visible
+	deadline := time.Now().Add(opts.VerifyTimeout)
+	for {
+		count, err := dst.Count(ctx, nil, nil)
+		if err != nil {
+			return err
+		}
+		result.TargetCount = countValue(count)
+		if result.TargetCount >= result.Written-result.Failed || time.Now().After(deadline) {
+			break
+		}
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if len(samples) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(samples))
+	for _, doc := range samples {
+		if k, ok := doc[keyField].(string); ok {
+			keys = append(keys, k)
+		}
+	}
+	found, err := dst.GetMany(ctx, keys, nil, &searchindex.GetManyOptions{KeyField: keyField, UseNumber: true})
+	if err != nil {
+		return err
+	}
+	for _, doc := range samples {
+		k, _ := doc[keyField].(string)
+		got, ok := found.Documents[k]
+		if !ok {
+			result.addMismatch(k, "missing from the target index")
+			continue
+		}
+		if diff := diffDocuments(doc, got); len(diff) > 0 {
+			result.addMismatch(k, fmt.Sprintf("fields differ: %v", diff))
+		}
+	}
+	return nil
+}
+
+func countValue(resp searchindex.DocumentsClientCountResponse) int64 {
+	if resp.Value == nil {
+		return 0
+	}
+	return *resp.Value
+}
+
+func (r *CopyResult) addMismatch(key, msg string) {
+	if r.Mismatches == nil {
+		r.Mismatches = map[string]string{}
+	}
+	r.Mismatches[key] = msg
+}
+
+// diffDocuments returns the fields of want whose values differ in got. Fields missing from got
+// are ignored, as they may not be retrievable in the target. Values are compared in their JSON form.
+func diffDocuments(want, got map[string]any) []string {
+	var diff []string
+	for name, w := range want {
+		g, ok := got[name]
+		if !ok {
+			continue
+		}
+		if !reflect.DeepEqual(normalizeJSON(w), normalizeJSON(g)) {
+			diff = append(diff, name)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+// normalizeJSON returns v as decoded from its JSON form, so values of different Go types compare equal.
+// Numbers are decoded as json.Number, so values that differ beyond float64 precision don't.
+func normalizeJSON(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&out); err != nil {
+		return v
+	}
+	return out
+}
+
+// reservoir keeps a uniform random sample of up to size items.
+type reservoir struct {
+	size  int
+	seen  int
+	items []map[string]any
+}
+
+func (r *reservoir) add(item map[string]any) {
+	r.seen++
+	if len(r.items) < r.size {
+		r.items = append(r.items, item)
+		return
+	}
+	if i := rand.IntN(r.seen); i < r.size {
+		r.items[i] = item
+	}
+}
@@ -0,0 +1,30 @@
+package azaisearch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiffDocuments(t *testing.T) {
+	tests := []struct {
+		name string
+		want map[string]any
+		got  map[string]any
+		diff []string
+	}{
+		{"equal", map[string]any{"id": "1", "n": json.Number("42")}, map[string]any{"id": "1", "n": float64(42)}, nil},
+		{"int64 intact", map[string]any{"n": json.Number("9007199254740993")}, map[string]any{"n": json.Number("9007199254740993")}, nil},
+		{"int64 rounded", map[string]any{"n": json.Number("9007199254740993")}, map[string]any{"n": float64(9007199254740993)}, []string{"n"}},
+		{"nested", map[string]any{"a": []any{map[string]any{"x": json.Number("1")}}}, map[string]any{"a": []any{map[string]any{"x": json.Number("2")}}}, []string{"a"}},
+		{"missing in got", map[string]any{"id": "1", "secret": "x"}, map[string]any{"id": "1"}, nil},
+		{"sorted", map[string]any{"b": "1", "a": "1"}, map[string]any{"b": "2", "a": "2"}, []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffDocuments(tt.want, tt.got); !reflect.DeepEqual(got, tt.diff) {
+				t.Errorf("diffDocuments() = %v, want %v", got, tt.diff)
+			}
+		})
+	}
+}
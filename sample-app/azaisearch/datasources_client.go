@@ -0,0 +1,56 @@
+package azaisearch
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+type DataSourcesClientOptions struct {
+	azcore.ClientOptions
+
+	// Audience overrides the token audience derived from ClientOptions.Cloud, e.g. for
+	// sovereign or private clouds. Setting it also disables endpoint validation.
+	Audience string
+}
+
+// NewDataSourcesClient creates a new instance of DataSourcesClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - client options, pass nil to accept the default values.
+func NewDataSourcesClient(endpoint string, cred azcore.TokenCredential, options *DataSourcesClientOptions) (*searchservice.DataSourcesClient, error) {
+	if options == nil {
+		options = &DataSourcesClientOptions{}
+	}
+
+	authPolicy, err := newBearerTokenPolicy(endpoint, cred, options.Cloud, options.Audience)
+	if err != nil {
+		return nil, err
+	}
+	return newDataSourcesClient(endpoint, authPolicy, options)
+}
+
+// NewDataSourcesClientWithSharedKey creates a new instance of DataSourcesClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - keyCred - used to authorize requests with a shared key
+//   - options - client options, pass nil to accept the default values.
+func NewDataSourcesClientWithSharedKey(endpoint string, keyCred *azcore.KeyCredential, options *DataSourcesClientOptions) (*searchservice.DataSourcesClient, error) {
+
+	authPolicy := runtime.NewKeyCredentialPolicy(keyCred, "api-key", &runtime.KeyCredentialPolicyOptions{})
+	return newDataSourcesClient(endpoint, authPolicy, options)
+}
+
+func newDataSourcesClient(endpoint string, authPolicy policy.Policy, options *DataSourcesClientOptions) (*searchservice.DataSourcesClient, error) {
+	if options == nil {
+		options = &DataSourcesClientOptions{}
+	}
+
+	c, err := newCoreClient(authPolicy, &options.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchservice.NewDataSourcesClient(endpoint, c)
+}
@@ -1,7 +1,6 @@
 package azaisearch
 
 import (
-	"sample-app/azaisearch/internal"
 	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -11,6 +10,10 @@ import (
 
 type DocumentClientOptions struct {
 	azcore.ClientOptions
+
+	// Audience overrides the token audience derived from ClientOptions.Cloud, e.g. for
+	// sovereign or private clouds. Setting it also disables endpoint validation.
+	Audience string
 }
 
 // NewDocumentsClient creates a new instance of DocumentsClient with the specified values.
@@ -19,8 +22,14 @@ type DocumentClientOptions struct {
 //   - credential - used to authorize requests. Usually a credential from azidentity.
 //   - options - client options, pass nil to accept the default values.
 func NewDocumentsClient(endpoint string, indexName string, cred azcore.TokenCredential, options *DocumentClientOptions) (*searchindex.DocumentsClient, error) {
+	if options == nil {
+		options = &DocumentClientOptions{}
+	}
 
-	authPolicy := runtime.NewBearerTokenPolicy(cred, []string{internal.TokenScope}, nil)
+	authPolicy, err := newBearerTokenPolicy(endpoint, cred, options.Cloud, options.Audience)
+	if err != nil {
+		return nil, err
+	}
 
 	return newDocumentsClient(endpoint, indexName, authPolicy, options)
 }
@@ -42,10 +51,7 @@ func newDocumentsClient(endpoint string, indexName string, authPolicy policy.Pol
 		options = &DocumentClientOptions{}
 	}
 
-	c, err := azcore.NewClient(moduleName, moduleVersion, runtime.PipelineOptions{
-		PerRetry: []policy.Policy{authPolicy},
-	}, &options.ClientOptions)
-
+	c, err := newCoreClient(authPolicy, &options.ClientOptions)
 	if err != nil {
 		return nil, err
 	}
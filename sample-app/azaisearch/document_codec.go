@@ -0,0 +1,220 @@
+package azaisearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Documents are mapped to and from Go structs with encoding/json. The index field
+// name of a struct field is taken from the name option of its search tag, e.g.
+// `search:"name=hotelId"`, and falls back to the json tag name or the Go field name.
+// The key names produced by encoding/json are renamed accordingly, recursively for
+// nested structs, slices and maps of structs.
+
+var jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+
+// encodeDocument converts v into the map representation used by IndexAction.AdditionalProperties.
+// Numbers are kept as json.Number, so Edm.Int64 values beyond 2^53 aren't rounded.
+func encodeDocument(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encoding document: %w", err)
+	}
+	var doc map[string]any
+	if err := unmarshalUseNumber(b, &doc); err != nil {
+		return nil, fmt.Errorf("encoding document: %T does not encode to a JSON object: %w", v, err)
+	}
+	renamed, _ := renameKeys(doc, reflect.TypeOf(v), true).(map[string]any)
+	return renamed, nil
+}
+
+// decodeDocument converts a document returned by the service into out, which must be a pointer.
+func decodeDocument(doc map[string]any, out any) error {
+	renamed := renameKeys(doc, reflect.TypeOf(out), false)
+	b, err := json.Marshal(renamed)
+	if err != nil {
+		return fmt.Errorf("decoding document: %w", err)
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("decoding document: %w", err)
+	}
+	return nil
+}
+
+// unmarshalUseNumber unmarshals data into v like json.Unmarshal, but decodes numbers as json.Number.
+func unmarshalUseNumber(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// renameKeys walks v alongside t and renames object keys from json names to index
+// field names (toIndex) or back.
+func renameKeys(v any, t reflect.Type, toIndex bool) any {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		return v
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return v
+		}
+		out := make(map[string]any, len(obj))
+		fields := documentFields(t)
+		for k, val := range obj {
+			f, ok := lookupField(fields, k, toIndex)
+			if !ok {
+				out[k] = val
+				continue
+			}
+			if toIndex {
+				out[f.indexName] = renameKeys(val, f.typ, toIndex)
+			} else {
+				out[f.jsonName] = renameKeys(val, f.typ, toIndex)
+			}
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		arr, ok := v.([]any)
+		if !ok {
+			return v
+		}
+		out := make([]any, len(arr))
+		for i, val := range arr {
+			out[i] = renameKeys(val, t.Elem(), toIndex)
+		}
+		return out
+	case reflect.Map:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return v
+		}
+		out := make(map[string]any, len(obj))
+		for k, val := range obj {
+			out[k] = renameKeys(val, t.Elem(), toIndex)
+		}
+		return out
+	}
+	return v
+}
+
+// documentField describes how a Go struct field maps to an index field.
+type documentField struct {
+	jsonName  string
+	indexName string
+	options   tagOptions
+	typ       reflect.Type
+}
+
+// documentFields returns the fields of struct type t as encoding/json sees them,
+// including the promoted fields of embedded structs.
+func documentFields(t reflect.Type) []documentField {
+	var fields []documentField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		jsonTag := sf.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+		jsonName, _, _ := strings.Cut(jsonTag, ",")
+
+		ft := sf.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if sf.Anonymous && jsonName == "" && ft.Kind() == reflect.Struct {
+			fields = append(fields, documentFields(ft)...)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if jsonName == "" {
+			jsonName = sf.Name
+		}
+
+		opts := parseSearchTag(sf.Tag.Get("search"))
+		indexName := jsonName
+		if name, ok := opts.value("name"); ok && name != "" {
+			indexName = name
+		}
+		fields = append(fields, documentField{
+			jsonName:  jsonName,
+			indexName: indexName,
+			options:   opts,
+			typ:       sf.Type,
+		})
+	}
+	return fields
+}
+
+func lookupField(fields []documentField, key string, byJSONName bool) (documentField, bool) {
+	for _, f := range fields {
+		if (byJSONName && f.jsonName == key) || (!byJSONName && f.indexName == key) {
+			return f, true
+		}
+	}
+	// encoding/json matches object keys case-insensitively when decoding
+	for _, f := range fields {
+		if (byJSONName && strings.EqualFold(f.jsonName, key)) || (!byJSONName && strings.EqualFold(f.indexName, key)) {
+			return f, true
+		}
+	}
+	return documentField{}, false
+}
+
+// tagOptions are the comma-separated options of a search struct tag. Options are
+// either flags (e.g. "key") or name=value pairs (e.g. "analyzer=en.microsoft").
+type tagOptions []string
+
+func parseSearchTag(tag string) tagOptions {
+	if tag == "" {
+		return nil
+	}
+	var opts tagOptions
+	for _, o := range strings.Split(tag, ",") {
+		if o = strings.TrimSpace(o); o != "" {
+			opts = append(opts, o)
+		}
+	}
+	return opts
+}
+
+// value returns the value of the name=value option name.
+func (o tagOptions) value(name string) (string, bool) {
+	for _, opt := range o {
+		if k, v, ok := strings.Cut(opt, "="); ok && k == name {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// flag returns the value of the boolean option name: true for a bare "name" flag, or the
+// parsed value of "name=true" and "name=false". It returns nil if the option is absent.
+func (o tagOptions) flag(name string) (*bool, error) {
+	for _, opt := range o {
+		k, v, hasValue := strings.Cut(opt, "=")
+		if k != name {
+			continue
+		}
+		b := true
+		if hasValue {
+			var err error
+			if b, err = strconv.ParseBool(v); err != nil {
+				return nil, fmt.Errorf("invalid value %q for search tag option %s", v, name)
+			}
+		}
+		return &b, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,35 @@
+package azaisearch
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestEncodeDocument(t *testing.T) {
+	type address struct {
+		City string `json:"city" search:"name=cityName"`
+	}
+	type hotel struct {
+		ID      string  `json:"id" search:"key"`
+		Views   int64   `json:"views"`
+		Rating  float64 `json:"rating"`
+		Address address `json:"address"`
+	}
+	doc, err := encodeDocument(hotel{ID: "1", Views: math.MaxInt64, Rating: 4.5, Address: address{City: "Seattle"}})
+	if err != nil {
+		t.Fatalf("encodeDocument() error = %v", err)
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"address":{"cityName":"Seattle"},"id":"1","rating":4.5,"views":9223372036854775807}`
+	if string(b) != want {
+		t.Errorf("encodeDocument() = %s, want %s", b, want)
+	}
+
+	if _, err := encodeDocument([]string{"a"}); err == nil {
+		t.Error("encodeDocument() of a slice error = nil")
+	}
+}
@@ -0,0 +1,320 @@
+// Package edm converts document values between their JSON form and Go types based on the Edm types
+// of the index fields, e.g. for documents returned by DocumentsClient.Get and SearchResult.AdditionalProperties.
+//
+//	Edm.String                 string
+//	Edm.Boolean                bool
+//	Edm.Int32                  int32
+//	Edm.Int64                  int64
+//	Edm.Double                 float64
+//	Edm.DateTimeOffset         time.Time
+//	Edm.GeographyPoint         odata.GeoPoint
+//	Edm.Single, Edm.Half       float32
+//	Edm.Int16, Edm.SByte       int16, int8
+//	Edm.Byte                   uint8
+//	Edm.ComplexType            map[string]any
+//	Collection(T)              a slice of the type of T, e.g. []float32 for vectors
+//
+// Edm.Int64 values beyond 2^53 lose precision when documents are decoded into map[string]any with
+// float64 numbers, as the generated clients do. Unmarshal decodes raw JSON without this loss.
+package edm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+	"sample-app/azaisearch/odata"
+)
+
+// goTypes maps primitive Edm types to the Go types of their decoded values.
+var goTypes = map[searchservice.SearchFieldDataType]reflect.Type{
+	searchservice.SearchFieldDataTypeString:         reflect.TypeFor[string](),
+	searchservice.SearchFieldDataTypeBoolean:        reflect.TypeFor[bool](),
+	searchservice.SearchFieldDataTypeInt32:          reflect.TypeFor[int32](),
+	searchservice.SearchFieldDataTypeInt64:          reflect.TypeFor[int64](),
+	searchservice.SearchFieldDataTypeDouble:         reflect.TypeFor[float64](),
+	searchservice.SearchFieldDataTypeDateTimeOffset: reflect.TypeFor[time.Time](),
+	searchservice.SearchFieldDataTypeGeographyPoint: reflect.TypeFor[odata.GeoPoint](),
+	searchservice.SearchFieldDataTypeSingle:         reflect.TypeFor[float32](),
+	searchservice.SearchFieldDataTypeHalf:           reflect.TypeFor[float32](),
+	searchservice.SearchFieldDataTypeInt16:          reflect.TypeFor[int16](),
+	searchservice.SearchFieldDataTypeSByte:          reflect.TypeFor[int8](),
+	searchservice.SearchFieldDataTypeByte:           reflect.TypeFor[uint8](),
+	searchservice.SearchFieldDataTypeComplex:        reflect.TypeFor[map[string]any](),
+}
+
+// GoType returns the Go type of decoded values of the Edm type t.
+func GoType(t searchservice.SearchFieldDataType) (reflect.Type, error) {
+	elem, collection := schema.ElementType(t)
+	typ, ok := goTypes[elem]
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+	if collection {
+		return reflect.SliceOf(typ), nil
+	}
+	return typ, nil
+}
+
+// Unmarshal decodes the JSON document data and converts its values with Decode. Numbers are
+// decoded without going through float64, so Edm.Int64 values keep their precision.
+func Unmarshal(fields []*searchservice.SearchField, data []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc map[string]any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return Decode(fields, doc)
+}
+
+// Decode returns a copy of doc whose values are converted to the Go types of their fields.
+// Keys that aren't fields, such as @search.score, are copied unchanged, except that json.Number values become float64.
+func Decode(fields []*searchservice.SearchField, doc map[string]any) (map[string]any, error) {
+	return convertDocument(fields, doc, "", decodeValue)
+}
+
+// DecodeValue converts v, a value of the Edm type t in its JSON form, to its Go type.
+func DecodeValue(t searchservice.SearchFieldDataType, v any) (any, error) {
+	return decodeValue(&searchservice.SearchField{Type: &t}, v, "")
+}
+
+// converter converts the value v of field f at path.
+type converter func(f *searchservice.SearchField, v any, path string) (any, error)
+
+func convertDocument(fields []*searchservice.SearchField, doc map[string]any, path string, convert converter) (map[string]any, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	out := make(map[string]any, len(doc))
+	for k, v := range doc {
+		f := findField(fields, k)
+		if f == nil {
+			out[k] = plain(v)
+			continue
+		}
+		c, err := convert(f, v, join(path, k))
+		if err != nil {
+			return nil, err
+		}
+		out[k] = c
+	}
+	return out, nil
+}
+
+// plain converts json.Number values in v, which Unmarshal decodes, to float64.
+func plain(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = plain(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, item := range v {
+			out[k] = plain(item)
+		}
+		return out
+	}
+	return v
+}
+
+func findField(fields []*searchservice.SearchField, name string) *searchservice.SearchField {
+	for _, f := range fields {
+		if f != nil && f.Name != nil && *f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "/" + name
+}
+
+func decodeValue(f *searchservice.SearchField, v any, path string) (any, error) {
+	if v == nil || f.Type == nil {
+		return v, nil
+	}
+	elem, collection := schema.ElementType(*f.Type)
+	if !collection {
+		return decodeElement(f, elem, v, path)
+	}
+
+	items, ok := v.([]any)
+	if !ok {
+		return nil, typeError(path, *f.Type, v)
+	}
+	typ, err := GoType(*f.Type)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	out := reflect.MakeSlice(typ, len(items), len(items))
+	for i, item := range items {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		if item == nil {
+			return nil, fmt.Errorf("%s: collections cannot contain null", itemPath)
+		}
+		c, err := decodeElement(f, elem, item, itemPath)
+		if err != nil {
+			return nil, err
+		}
+		out.Index(i).Set(reflect.ValueOf(c))
+	}
+	return out.Interface(), nil
+}
+
+func decodeElement(f *searchservice.SearchField, t searchservice.SearchFieldDataType, v any, path string) (any, error) {
+	switch t {
+	case searchservice.SearchFieldDataTypeString:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case searchservice.SearchFieldDataTypeBoolean:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case searchservice.SearchFieldDataTypeInt64, searchservice.SearchFieldDataTypeInt32, searchservice.SearchFieldDataTypeInt16,
+		searchservice.SearchFieldDataTypeSByte:
+		n, ok := toInt64(v)
+		if !ok {
+			break
+		}
+		switch t {
+		case searchservice.SearchFieldDataTypeInt32:
+			if n >= math.MinInt32 && n <= math.MaxInt32 {
+				return int32(n), nil
+			}
+		case searchservice.SearchFieldDataTypeInt16:
+			if n >= math.MinInt16 && n <= math.MaxInt16 {
+				return int16(n), nil
+			}
+		case searchservice.SearchFieldDataTypeSByte:
+			if n >= math.MinInt8 && n <= math.MaxInt8 {
+				return int8(n), nil
+			}
+		default:
+			return n, nil
+		}
+		return nil, fmt.Errorf("%s: %d is out of range for %s", path, n, t)
+	case searchservice.SearchFieldDataTypeByte:
+		if n, ok := toInt64(v); ok && n >= 0 && n <= math.MaxUint8 {
+			return uint8(n), nil
+		}
+	case searchservice.SearchFieldDataTypeDouble:
+		if x, ok := toFloat64(v); ok {
+			return x, nil
+		}
+	case searchservice.SearchFieldDataTypeSingle, searchservice.SearchFieldDataTypeHalf:
+		if x, ok := toFloat64(v); ok {
+			return float32(x), nil
+		}
+	case searchservice.SearchFieldDataTypeDateTimeOffset:
+		if s, ok := v.(string); ok {
+			tm, err := searchservice.ParseDateTimeOffset(s)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			return tm, nil
+		}
+	case searchservice.SearchFieldDataTypeGeographyPoint:
+		if m, ok := v.(map[string]any); ok {
+			b, err := json.Marshal(m)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			var p odata.GeoPoint
+			if err := json.Unmarshal(b, &p); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			return p, nil
+		}
+	case searchservice.SearchFieldDataTypeComplex:
+		if m, ok := v.(map[string]any); ok {
+			return convertDocument(f.Fields, m, path, decodeValue)
+		}
+	default:
+		return nil, fmt.Errorf("%s: unsupported type %s", path, t)
+	}
+	return nil, typeError(path, t, v)
+}
+
+func typeError(path string, t searchservice.SearchFieldDataType, v any) error {
+	return fmt.Errorf("%s: cannot convert %s to %s", path, jsonType(v), t)
+}
+
+// toInt64 converts a JSON number to an integer if it has no fractional part.
+func toInt64(v any) (int64, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n, true
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return 0, false
+		}
+		return toInt64(f)
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v), true
+	}
+	return integer(reflect.ValueOf(v))
+}
+
+// toFloat64 converts a JSON number, or one of the strings NaN, INF and -INF, to a float.
+func toFloat64(v any) (float64, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case string:
+		switch strings.ToUpper(v) {
+		case "NAN":
+			return math.NaN(), true
+		case "INF":
+			return math.Inf(1), true
+		case "-INF":
+			return math.Inf(-1), true
+		}
+		return 0, false
+	}
+	if n, ok := integer(reflect.ValueOf(v)); ok {
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// integer returns the value of v if it is of an integer kind that fits into an int64.
+func integer(v reflect.Value) (int64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() <= math.MaxInt64 {
+			return int64(v.Uint()), true
+		}
+	}
+	return 0, false
+}
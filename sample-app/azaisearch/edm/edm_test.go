@@ -0,0 +1,201 @@
+package edm
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+	"sample-app/azaisearch/odata"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func field(name string, t searchservice.SearchFieldDataType, subfields ...*searchservice.SearchField) *searchservice.SearchField {
+	return &searchservice.SearchField{Name: &name, Type: &t, Fields: subfields}
+}
+
+// hotelFields are the fields of the test index.
+var hotelFields = []*searchservice.SearchField{
+	{Name: ptr("id"), Type: ptr(searchservice.SearchFieldDataTypeString), Key: ptr(true)},
+	field("views", searchservice.SearchFieldDataTypeInt64),
+	field("rooms", searchservice.SearchFieldDataTypeInt32),
+	field("rating", searchservice.SearchFieldDataTypeDouble),
+	field("updated", searchservice.SearchFieldDataTypeDateTimeOffset),
+	field("location", searchservice.SearchFieldDataTypeGeographyPoint),
+	field("tags", "Collection(Edm.String)"),
+	{Name: ptr("vector"), Type: ptr(searchservice.SearchFieldDataType("Collection(Edm.Single)")), VectorSearchDimensions: ptr(int32(2))},
+	field("address", searchservice.SearchFieldDataTypeComplex,
+		field("city", searchservice.SearchFieldDataTypeString),
+	),
+}
+
+func TestGoType(t *testing.T) {
+	tests := []struct {
+		typ  searchservice.SearchFieldDataType
+		want reflect.Type
+	}{
+		{searchservice.SearchFieldDataTypeString, reflect.TypeFor[string]()},
+		{searchservice.SearchFieldDataTypeInt64, reflect.TypeFor[int64]()},
+		{searchservice.SearchFieldDataTypeDateTimeOffset, reflect.TypeFor[time.Time]()},
+		{searchservice.SearchFieldDataTypeGeographyPoint, reflect.TypeFor[odata.GeoPoint]()},
+		{searchservice.SearchFieldDataTypeComplex, reflect.TypeFor[map[string]any]()},
+		{"Collection(Edm.Single)", reflect.TypeFor[[]float32]()},
+		{"Collection(Edm.SByte)", reflect.TypeFor[[]int8]()},
+		{"Collection(Edm.ComplexType)", reflect.TypeFor[[]map[string]any]()},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.typ), func(t *testing.T) {
+			got, err := GoType(tt.typ)
+			if err != nil {
+				t.Fatalf("GoType() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("GoType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := GoType("Edm.Unknown"); err == nil {
+		t.Error("GoType(Edm.Unknown) error = nil")
+	}
+}
+
+func TestDecodeValue(t *testing.T) {
+	updated := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name string
+		typ  searchservice.SearchFieldDataType
+		v    any
+		want any
+	}{
+		{"string", searchservice.SearchFieldDataTypeString, "a", "a"},
+		{"boolean", searchservice.SearchFieldDataTypeBoolean, true, true},
+		{"int32", searchservice.SearchFieldDataTypeInt32, float64(42), int32(42)},
+		{"int64 float", searchservice.SearchFieldDataTypeInt64, float64(1 << 40), int64(1 << 40)},
+		{"int64 number", searchservice.SearchFieldDataTypeInt64, json.Number("9223372036854775807"), int64(math.MaxInt64)},
+		{"int16", searchservice.SearchFieldDataTypeInt16, float64(-5), int16(-5)},
+		{"byte", searchservice.SearchFieldDataTypeByte, float64(255), uint8(255)},
+		{"double", searchservice.SearchFieldDataTypeDouble, 4.5, 4.5},
+		{"double infinity", searchservice.SearchFieldDataTypeDouble, "-INF", math.Inf(-1)},
+		{"single", searchservice.SearchFieldDataTypeSingle, 0.5, float32(0.5)},
+		{"date", searchservice.SearchFieldDataTypeDateTimeOffset, "2024-01-02T03:04:05Z", updated},
+		{"point", searchservice.SearchFieldDataTypeGeographyPoint, map[string]any{"type": "Point", "coordinates": []any{-122.1, 47.6}}, odata.Point(-122.1, 47.6)},
+		{"collection", "Collection(Edm.Single)", []any{0.5, 1.0}, []float32{0.5, 1}},
+		{"empty collection", "Collection(Edm.String)", []any{}, []string{}},
+		{"null", searchservice.SearchFieldDataTypeInt32, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeValue(tt.typ, tt.v)
+			if err != nil {
+				t.Fatalf("DecodeValue() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeValueErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  searchservice.SearchFieldDataType
+		v    any
+	}{
+		{"string as int", searchservice.SearchFieldDataTypeInt32, "1"},
+		{"fraction as int", searchservice.SearchFieldDataTypeInt64, 1.5},
+		{"int32 overflow", searchservice.SearchFieldDataTypeInt32, float64(math.MaxInt32 + 1)},
+		{"sbyte overflow", searchservice.SearchFieldDataTypeSByte, float64(128)},
+		{"negative byte", searchservice.SearchFieldDataTypeByte, float64(-1)},
+		{"bad date", searchservice.SearchFieldDataTypeDateTimeOffset, "yesterday"},
+		{"scalar as collection", "Collection(Edm.String)", "a"},
+		{"null in collection", "Collection(Edm.String)", []any{"a", nil}},
+		{"unsupported type", "Edm.Unknown", "a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := DecodeValue(tt.typ, tt.v); err == nil {
+				t.Errorf("DecodeValue() = %#v, want an error", got)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	data := []byte(`{"id": "1", "views": 9007199254740993, "rating": 4.5, "address": {"city": "Seattle"}, "@search.score": 1.5}`)
+	got, err := Unmarshal(hotelFields, data)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	want := map[string]any{
+		"id":            "1",
+		"views":         int64(9007199254740993),
+		"rating":        4.5,
+		"address":       map[string]any{"city": "Seattle"},
+		"@search.score": 1.5,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmarshal() = %#v, want %#v", got, want)
+	}
+}
+
+func TestEncodeValue(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  searchservice.SearchFieldDataType
+		v    any
+		want any
+	}{
+		{"int64 from int", searchservice.SearchFieldDataTypeInt64, 7, int64(7)},
+		{"int32 from pointer", searchservice.SearchFieldDataTypeInt32, ptr(3), int32(3)},
+		{"double nan", searchservice.SearchFieldDataTypeDouble, math.NaN(), "NaN"},
+		{"single infinity", searchservice.SearchFieldDataTypeSingle, float32(math.Inf(1)), "INF"},
+		{"date", searchservice.SearchFieldDataTypeDateTimeOffset, time.Date(2024, 1, 2, 4, 4, 5, 0, time.FixedZone("", 3600)), "2024-01-02T03:04:05Z"},
+		{"date string", searchservice.SearchFieldDataTypeDateTimeOffset, "2024-01-02T04:04:05+01:00", "2024-01-02T03:04:05Z"},
+		{"point", searchservice.SearchFieldDataTypeGeographyPoint, odata.Point(-122.1, 47.6), map[string]any{"type": "Point", "coordinates": []any{-122.1, 47.6}}},
+		{"collection", "Collection(Edm.Int32)", []int{1, 2}, []any{int32(1), int32(2)}},
+		{"nil", searchservice.SearchFieldDataTypeString, (*string)(nil), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeValue(tt.typ, tt.v)
+			if err != nil {
+				t.Fatalf("EncodeValue() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EncodeValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+	if got, err := EncodeValue(searchservice.SearchFieldDataTypeInt32, int64(math.MaxInt32+1)); err == nil {
+		t.Errorf("EncodeValue() of an out-of-range int32 = %#v, want an error", got)
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	doc := map[string]any{
+		"id":       "1",
+		"views":    int64(math.MaxInt64),
+		"updated":  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		"location": odata.Point(-122.1, 47.6),
+		"tags":     []string{"pool", "view"},
+		"vector":   []float32{0.5, 1},
+		"address":  map[string]any{"city": "Seattle"},
+	}
+	b, err := Marshal(hotelFields, doc)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	got, err := Unmarshal(hotelFields, b)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, doc) {
+		t.Errorf("Unmarshal(Marshal()) = %#v, want %#v", got, doc)
+	}
+}
@@ -0,0 +1,139 @@
+package edm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+	"sample-app/azaisearch/odata"
+)
+
+// Encode returns a copy of doc whose values are converted to the JSON form of their fields' Edm types,
+// ready to be used in an IndexAction. It accepts the types returned by Decode, as well as other
+// integer and float types for numeric fields and RFC 3339 strings for Edm.DateTimeOffset fields.
+// Keys that aren't fields, such as @search.action, are copied unchanged.
+func Encode(fields []*searchservice.SearchField, doc map[string]any) (map[string]any, error) {
+	return convertDocument(fields, doc, "", encodeValue)
+}
+
+// Marshal encodes doc with Encode and returns its JSON form.
+func Marshal(fields []*searchservice.SearchField, doc map[string]any) ([]byte, error) {
+	encoded, err := Encode(fields, doc)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(encoded)
+}
+
+// EncodeValue converts v to the JSON form of the Edm type t.
+func EncodeValue(t searchservice.SearchFieldDataType, v any) (any, error) {
+	return encodeValue(&searchservice.SearchField{Type: &t}, v, "")
+}
+
+func encodeValue(f *searchservice.SearchField, v any, path string) (any, error) {
+	rv := indirect(reflect.ValueOf(v))
+	if !rv.IsValid() || f.Type == nil {
+		return nil, nil
+	}
+	elem, collection := schema.ElementType(*f.Type)
+	if !collection {
+		return encodeElement(f, elem, rv.Interface(), path)
+	}
+
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, typeError(path, *f.Type, v)
+	}
+	out := make([]any, rv.Len())
+	for i := range out {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		item := indirect(rv.Index(i))
+		if !item.IsValid() {
+			return nil, fmt.Errorf("%s: collections cannot contain null", itemPath)
+		}
+		c, err := encodeElement(f, elem, item.Interface(), itemPath)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = c
+	}
+	return out, nil
+}
+
+func encodeElement(f *searchservice.SearchField, t searchservice.SearchFieldDataType, v any, path string) (any, error) {
+	switch t {
+	case searchservice.SearchFieldDataTypeString:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case searchservice.SearchFieldDataTypeBoolean:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case searchservice.SearchFieldDataTypeInt64, searchservice.SearchFieldDataTypeInt32, searchservice.SearchFieldDataTypeInt16,
+		searchservice.SearchFieldDataTypeSByte, searchservice.SearchFieldDataTypeByte:
+		if n, ok := toInt64(v); ok {
+			// reuse the range checks of decoding
+			return decodeElement(f, t, n, path)
+		}
+	case searchservice.SearchFieldDataTypeDouble, searchservice.SearchFieldDataTypeSingle, searchservice.SearchFieldDataTypeHalf:
+		x, ok := toFloat64(v)
+		if !ok {
+			break
+		}
+		switch {
+		case math.IsNaN(x):
+			return "NaN", nil
+		case math.IsInf(x, 1):
+			return "INF", nil
+		case math.IsInf(x, -1):
+			return "-INF", nil
+		case t == searchservice.SearchFieldDataTypeDouble:
+			return x, nil
+		}
+		return float32(x), nil
+	case searchservice.SearchFieldDataTypeDateTimeOffset:
+		switch v := v.(type) {
+		case time.Time:
+			return v.UTC().Format(time.RFC3339Nano), nil
+		case string:
+			tm, err := searchservice.ParseDateTimeOffset(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			return tm.UTC().Format(time.RFC3339Nano), nil
+		}
+	case searchservice.SearchFieldDataTypeGeographyPoint:
+		switch v := v.(type) {
+		case odata.GeoPoint:
+			return map[string]any{"type": "Point", "coordinates": []any{v.Longitude, v.Latitude}}, nil
+		case map[string]any:
+			p, err := decodeElement(f, t, v, path)
+			if err != nil {
+				return nil, err
+			}
+			return encodeElement(f, t, p, path)
+		}
+	case searchservice.SearchFieldDataTypeComplex:
+		if m, ok := v.(map[string]any); ok {
+			return convertDocument(f.Fields, m, path, encodeValue)
+		}
+	default:
+		return nil, fmt.Errorf("%s: unsupported type %s", path, t)
+	}
+	return nil, typeError(path, t, v)
+}
+
+// indirect dereferences pointers and interfaces, returning the zero Value for nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
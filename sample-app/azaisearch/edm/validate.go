@@ -0,0 +1,266 @@
+package edm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// ValidationError is a problem with a value in an indexing batch.
+type ValidationError struct {
+	// JSON path of the value in the request body, e.g. $.value[2].address.city.
+	Path string
+
+	// Key of the document, if it has one.
+	Key string
+
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors are the problems found by ValidateBatch.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// ValidateBatch checks the actions of batch against index before they are sent, catching errors
+// the service would otherwise report per document in IndexingResult.ErrorMessage:
+//   - the key field must be present and a non-empty string of letters, digits, '_', '-' and '='
+//   - fields must exist in the index
+//   - values must match the Edm types of their fields, including collections and complex fields
+//   - vectors must have VectorSearchDimensions elements
+//
+// Actions are checked in their JSON form, so documents may contain any values that marshal to
+// valid JSON for their fields. Delete actions are only checked for their key. It returns
+// ValidationErrors, or nil if the batch is valid.
+func ValidateBatch(index *searchservice.SearchIndex, batch searchindex.IndexBatch) error {
+	if index == nil {
+		return errors.New("index is required")
+	}
+	key, err := schema.KeyField(index)
+	if err != nil {
+		return err
+	}
+	v := &validator{}
+	for i, action := range batch.Actions {
+		path := fmt.Sprintf("$.value[%d]", i)
+		if action == nil {
+			v.add(path, "", "action is null")
+			continue
+		}
+		doc, err := toJSON(action.AdditionalProperties)
+		if err != nil {
+			v.add(path, "", "%s", err)
+			continue
+		}
+		v.action(index, key, action.ActionType, doc, path)
+	}
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// ValidateDocument checks a single document for an action of type actionType, see ValidateBatch.
+func ValidateDocument(index *searchservice.SearchIndex, actionType searchindex.IndexActionType, doc map[string]any) error {
+	return ValidateBatch(index, searchindex.IndexBatch{
+		Actions: []*searchindex.IndexAction{{ActionType: &actionType, AdditionalProperties: doc}},
+	})
+}
+
+// toJSON returns doc as it is sent to the service, with numbers as json.Number.
+func toJSON(doc map[string]any) (map[string]any, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var out map[string]any
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type validator struct {
+	key  string // key of the current document
+	errs ValidationErrors
+}
+
+func (v *validator) add(path, key, format string, args ...any) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Key: key, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) errorf(path, format string, args ...any) {
+	v.add(path, v.key, format, args...)
+}
+
+func (v *validator) action(index *searchservice.SearchIndex, key *searchservice.SearchField, actionType *searchindex.IndexActionType, doc map[string]any, path string) {
+	v.key = ""
+	keyPath := path + "." + *key.Name
+	switch k, ok := doc[*key.Name]; {
+	case !ok || k == nil:
+		v.errorf(keyPath, "key field is missing")
+	case !isString(k):
+		v.errorf(keyPath, "key must be a string, got %s", jsonType(k))
+	default:
+		v.key = k.(string)
+		if msg := checkKey(v.key); msg != "" {
+			v.errorf(keyPath, "%s", msg)
+		}
+	}
+	if actionType != nil && *actionType == searchindex.IndexActionTypeDelete {
+		return
+	}
+	// the key has been checked; doc is a copy made by toJSON
+	delete(doc, *key.Name)
+	v.object(index.Fields, doc, path)
+}
+
+func isString(v any) bool {
+	_, ok := v.(string)
+	return ok
+}
+
+// checkKey returns why key is not a valid document key, or "".
+func checkKey(key string) string {
+	if key == "" {
+		return "key must not be empty"
+	}
+	for _, c := range key {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-' || c == '=') {
+			return fmt.Sprintf("key contains %q; keys may only contain letters, digits, '_', '-' and '=' (consider URL-safe base64)", c)
+		}
+	}
+	return ""
+}
+
+func (v *validator) object(fields []*searchservice.SearchField, doc map[string]any, path string) {
+	for _, name := range slices.Sorted(maps.Keys(doc)) {
+		value := doc[name]
+		if strings.HasPrefix(name, "@") {
+			// annotations such as @search.action
+			continue
+		}
+		fieldPath := path + "." + name
+		f := findField(fields, name)
+		if f == nil {
+			v.errorf(fieldPath, "field does not exist in the index")
+			continue
+		}
+		v.value(f, value, fieldPath)
+	}
+}
+
+func (v *validator) value(f *searchservice.SearchField, value any, path string) {
+	if value == nil || f.Type == nil {
+		return
+	}
+	elem, collection := schema.ElementType(*f.Type)
+	if !collection {
+		v.element(f, elem, value, path)
+		return
+	}
+	items, ok := value.([]any)
+	if !ok {
+		v.errorf(path, "expected %s, got %s", *f.Type, jsonType(value))
+		return
+	}
+	if f.VectorSearchDimensions != nil && len(items) != int(*f.VectorSearchDimensions) {
+		v.errorf(path, "vector has %d dimensions, but the field has %d", len(items), *f.VectorSearchDimensions)
+	}
+	for i, item := range items {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		if item == nil {
+			v.errorf(itemPath, "collections cannot contain null")
+			continue
+		}
+		v.element(f, elem, item, itemPath)
+	}
+}
+
+func (v *validator) element(f *searchservice.SearchField, t searchservice.SearchFieldDataType, value any, path string) {
+	if t == searchservice.SearchFieldDataTypeComplex {
+		m, ok := value.(map[string]any)
+		if !ok {
+			v.errorf(path, "expected an object for %s, got %s", t, jsonType(value))
+			return
+		}
+		v.object(f.Fields, m, path)
+		return
+	}
+	if t == searchservice.SearchFieldDataTypeGeographyPoint {
+		if msg := checkGeoPoint(value); msg != "" {
+			v.errorf(path, "%s", msg)
+		}
+		return
+	}
+	if _, err := decodeElement(f, t, value, path); err != nil {
+		// decodeElement prefixes the path, which the ValidationError carries separately
+		v.errorf(path, "%s", strings.TrimPrefix(err.Error(), path+": "))
+	}
+}
+
+// checkGeoPoint returns why v is not a valid GeoJSON point, or "".
+func checkGeoPoint(v any) string {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return fmt.Sprintf("expected a GeoJSON point, got %s", jsonType(v))
+	}
+	if m["type"] != "Point" {
+		return `GeoJSON point must have type "Point"`
+	}
+	coords, ok := m["coordinates"].([]any)
+	if !ok || len(coords) != 2 {
+		return "GeoJSON point must have coordinates [longitude, latitude]"
+	}
+	lon, ok1 := toFloat64(coords[0])
+	lat, ok2 := toFloat64(coords[1])
+	switch {
+	case !ok1 || !ok2 || math.IsNaN(lon) || math.IsNaN(lat):
+		return "GeoJSON point coordinates must be numbers"
+	case lon < -180 || lon > 180:
+		return fmt.Sprintf("longitude %g is out of range [-180, 180]", lon)
+	case lat < -90 || lat > 90:
+		return fmt.Sprintf("latitude %g is out of range [-90, 90]", lat)
+	}
+	return ""
+}
+
+// jsonType returns the JSON type name of a decoded JSON value.
+func jsonType(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number, float64:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,80 @@
+package edm
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+func TestValidateDocument(t *testing.T) {
+	index := &searchservice.SearchIndex{Name: ptr("hotels"), Fields: hotelFields}
+	tests := []struct {
+		name   string
+		action searchindex.IndexActionType
+		doc    map[string]any
+		want   []string
+	}{
+		{
+			name:   "valid",
+			action: searchindex.IndexActionTypeUpload,
+			doc: map[string]any{
+				"id": "a-1", "views": int64(1) << 60, "rating": 4.5, "tags": []string{"pool"}, "vector": []float32{1, 2},
+				"location": map[string]any{"type": "Point", "coordinates": []any{-122.1, 47.6}},
+				"address":  map[string]any{"city": "Seattle"}, "@search.action": "upload",
+			},
+		},
+		{
+			name:   "missing key",
+			action: searchindex.IndexActionTypeMerge,
+			doc:    map[string]any{"rating": 1},
+			want:   []string{"$.value[0].id: key field is missing"},
+		},
+		{
+			name:   "invalid key",
+			action: searchindex.IndexActionTypeDelete,
+			doc:    map[string]any{"id": "a/b", "rating": "bad"},
+			want:   []string{`$.value[0].id: key contains '/'; keys may only contain letters, digits, '_', '-' and '=' (consider URL-safe base64)`},
+		},
+		{
+			name:   "unknown field",
+			action: searchindex.IndexActionTypeUpload,
+			doc:    map[string]any{"id": "1", "stars": 5},
+			want:   []string{"$.value[0].stars: field does not exist in the index"},
+		},
+		{
+			name:   "type mismatches",
+			action: searchindex.IndexActionTypeUpload,
+			doc: map[string]any{
+				"id": "1", "rooms": 1.5, "tags": "pool", "vector": []float32{1},
+				"address": map[string]any{"city": 3}, "location": map[string]any{"type": "Point", "coordinates": []any{200, 0}},
+			},
+			want: []string{
+				"$.value[0].address.city: cannot convert number to Edm.String",
+				"$.value[0].location: longitude 200 is out of range [-180, 180]",
+				"$.value[0].rooms: cannot convert number to Edm.Int32",
+				"$.value[0].tags: expected Collection(Edm.String), got string",
+				"$.value[0].vector: vector has 1 dimensions, but the field has 2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDocument(index, tt.action, tt.doc)
+			var got []string
+			var errs ValidationErrors
+			if errors.As(err, &errs) {
+				for _, e := range errs {
+					got = append(got, e.Error())
+				}
+			} else if err != nil {
+				t.Fatalf("ValidateDocument() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ValidateDocument() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Package facet builds facet expressions for SearchRequest.Facets and SearchOptions.Facets and
+// decodes the facet results of a search into typed buckets.
+//
+//	req.Facets = facet.Pointers(
+//		facet.Field("category").Count(10).Sort(facet.SortCountDesc),
+//		facet.Field("rating").Values(2, 3, 4),
+//		facet.Field("lastRenovated").DateInterval(facet.Year),
+//	)
+package facet
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sort is the order of the buckets of a value facet.
+type Sort string
+
+const (
+	// SortCountDesc orders buckets by descending count (the service default).
+	SortCountDesc Sort = "count"
+	// SortCountAsc orders buckets by ascending count.
+	SortCountAsc Sort = "-count"
+	// SortValueAsc orders buckets by ascending value.
+	SortValueAsc Sort = "value"
+	// SortValueDesc orders buckets by descending value.
+	SortValueDesc Sort = "-value"
+)
+
+// DateUnit is the bucket size of an interval facet on an Edm.DateTimeOffset field.
+type DateUnit string
+
+// Units for DateInterval.
+const (
+	Minute  DateUnit = "minute"
+	Hour    DateUnit = "hour"
+	Day     DateUnit = "day"
+	Week    DateUnit = "week"
+	Month   DateUnit = "month"
+	Quarter DateUnit = "quarter"
+	Year    DateUnit = "year"
+)
+
+// Expr is a facet expression: a field name followed by comma-separated name:value parameters.
+type Expr struct {
+	field  string
+	params []string
+}
+
+// Field starts a facet expression on the facetable field name. Without further parameters
+// it returns the top values of the field.
+func Field(name string) Expr {
+	return Expr{field: name}
+}
+
+// Name returns the field name of the facet, which is also the key of its results.
+func (e Expr) Name() string {
+	return e.field
+}
+
+func (e Expr) with(name, value string) Expr {
+	params := make([]string, 0, len(e.params)+1)
+	for _, p := range e.params {
+		if !strings.HasPrefix(p, name+":") {
+			params = append(params, p)
+		}
+	}
+	e.params = append(params, name+":"+value)
+	return e
+}
+
+// Count sets the maximum number of value buckets. The service default is 10.
+func (e Expr) Count(n int) Expr {
+	return e.with("count", strconv.Itoa(n))
+}
+
+// Sort sets the order of the value buckets.
+func (e Expr) Sort(s Sort) Expr {
+	return e.with("sort", string(s))
+}
+
+// Values creates range buckets between the given boundaries of a numeric field, which must be in
+// ascending order. With boundaries a, b, the buckets are (-inf, a), [a, b) and [b, +inf).
+func (e Expr) Values(boundaries ...float64) Expr {
+	values := make([]string, len(boundaries))
+	for i, b := range boundaries {
+		values[i] = strconv.FormatFloat(b, 'f', -1, 64)
+	}
+	return e.with("values", strings.Join(values, "|"))
+}
+
+// DateValues creates range buckets between the given boundaries of an Edm.DateTimeOffset field,
+// which must be in ascending order.
+func (e Expr) DateValues(boundaries ...time.Time) Expr {
+	values := make([]string, len(boundaries))
+	for i, b := range boundaries {
+		values[i] = b.UTC().Format(time.RFC3339Nano)
+	}
+	return e.with("values", strings.Join(values, "|"))
+}
+
+// Interval creates buckets of the given size for a numeric field. Each bucket's value is its lower bound.
+func (e Expr) Interval(size float64) Expr {
+	return e.with("interval", strconv.FormatFloat(size, 'f', -1, 64))
+}
+
+// DateInterval creates buckets of the given unit for an Edm.DateTimeOffset field.
+// Each bucket's value is the start of its period.
+func (e Expr) DateInterval(unit DateUnit) Expr {
+	return e.with("interval", string(unit))
+}
+
+// TimeOffset sets the UTC offset used to determine the boundaries of a DateInterval, e.g. "+01:00".
+func (e Expr) TimeOffset(offset string) Expr {
+	return e.with("timeoffset", offset)
+}
+
+// String returns the facet expression.
+func (e Expr) String() string {
+	if len(e.params) == 0 {
+		return e.field
+	}
+	return e.field + "," + strings.Join(e.params, ",")
+}
+
+// Strings returns the facet expressions as used by SearchOptions.Facets.
+func Strings(exprs ...Expr) []string {
+	s := make([]string, len(exprs))
+	for i, e := range exprs {
+		s[i] = e.String()
+	}
+	return s
+}
+
+// Pointers returns the facet expressions as used by SearchRequest.Facets.
+func Pointers(exprs ...Expr) []*string {
+	s := make([]*string, len(exprs))
+	for i, e := range exprs {
+		str := e.String()
+		s[i] = &str
+	}
+	return s
+}
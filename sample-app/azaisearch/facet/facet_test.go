@@ -0,0 +1,26 @@
+package facet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExprString(t *testing.T) {
+	tests := []struct {
+		expr Expr
+		want string
+	}{
+		{Field("category"), "category"},
+		{Field("category").Count(5).Sort(SortCountDesc), "category,count:5,sort:count"},
+		{Field("category").Count(5).Count(20), "category,count:20"},
+		{Field("rating").Values(1, 2.5, 4), "rating,values:1|2.5|4"},
+		{Field("price").Interval(10), "price,interval:10"},
+		{Field("updated").DateValues(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), "updated,values:2024-01-01T00:00:00Z"},
+		{Field("updated").DateInterval(Month).TimeOffset("+01:00"), "updated,interval:month,timeoffset:+01:00"},
+	}
+	for _, tt := range tests {
+		if got := tt.expr.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,532 @@
+package facet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+	"sample-app/azaisearch/odata"
+)
+
+// rangeSeparator separates the bounds of a range selection, e.g. "100..200", "..100" or "200..".
+const rangeSeparator = ".."
+
+// Navigation tracks the facet buckets a user has selected for drill-down. Selections within a
+// facet are combined with or, selections of different facets with and.
+//
+// Selections are kept in their URL form: the value of a value bucket, or "from..to" for a range
+// bucket of a numeric or date facet, where either bound may be empty.
+//
+// A Navigation is not safe for concurrent modification.
+type Navigation struct {
+	facets   []navigationFacet
+	selected map[string][]string
+}
+
+type navigationFacet struct {
+	expr       Expr
+	typ        searchservice.SearchFieldDataType
+	collection bool
+}
+
+// NewNavigation creates a Navigation for the given facets of index. The facet fields must exist,
+// be facetable and filterable, and be of a primitive type.
+func NewNavigation(index *searchservice.SearchIndex, facets ...Expr) (*Navigation, error) {
+	if index == nil {
+		return nil, errors.New("index is required")
+	}
+	n := &Navigation{selected: map[string][]string{}}
+	var errs []error
+	for _, e := range facets {
+		f, err := schema.FindField(index.Fields, e.Name())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if f.Type == nil || schema.IsComplex(f) {
+			errs = append(errs, fmt.Errorf("field %q is not of a primitive type", e.Name()))
+			continue
+		}
+		if !schema.Attribute(f.Facetable, true) {
+			errs = append(errs, fmt.Errorf("field %q is not facetable", e.Name()))
+		}
+		if !schema.Attribute(f.Filterable, true) {
+			errs = append(errs, fmt.Errorf("field %q is not filterable", e.Name()))
+		}
+		if n.facet(e.Name()) != nil {
+			errs = append(errs, fmt.Errorf("duplicate facet %q", e.Name()))
+		}
+		typ, collection := schema.ElementType(*f.Type)
+		n.facets = append(n.facets, navigationFacet{expr: e, typ: typ, collection: collection})
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (n *Navigation) facet(field string) *navigationFacet {
+	for i := range n.facets {
+		if n.facets[i].expr.Name() == field {
+			return &n.facets[i]
+		}
+	}
+	return nil
+}
+
+func (n *Navigation) lookup(field string) (*navigationFacet, error) {
+	f := n.facet(field)
+	if f == nil {
+		return nil, fmt.Errorf("unknown facet %q", field)
+	}
+	return f, nil
+}
+
+// Select selects the value bucket value of the facet field. value may be the bucket's value,
+// e.g. from ValueBucket.Value, or its URL form.
+func (n *Navigation) Select(field string, value any) error {
+	s, err := n.selection(field, value)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(n.selected[field], s) {
+		n.selected[field] = append(n.selected[field], s)
+	}
+	return nil
+}
+
+// SelectRange selects the range bucket [from, to) of the numeric or date facet field. Nil bounds,
+// including nil pointers such as RangeBucket.From of the first bucket, are open.
+func (n *Navigation) SelectRange(field string, from, to any) error {
+	return n.Select(field, rangeSelection(from, to))
+}
+
+// Deselect removes the selection value of the facet field, if selected.
+func (n *Navigation) Deselect(field string, value any) error {
+	s, err := n.selection(field, value)
+	if err != nil {
+		return err
+	}
+	n.selected[field] = slices.DeleteFunc(n.selected[field], func(v string) bool { return v == s })
+	if len(n.selected[field]) == 0 {
+		delete(n.selected, field)
+	}
+	return nil
+}
+
+// DeselectRange removes the range selection [from, to) of the facet field, if selected.
+func (n *Navigation) DeselectRange(field string, from, to any) error {
+	return n.Deselect(field, rangeSelection(from, to))
+}
+
+// IsSelected reports whether value, in any form accepted by Select, is selected for the facet field.
+func (n *Navigation) IsSelected(field string, value any) bool {
+	s, err := n.selection(field, value)
+	return err == nil && slices.Contains(n.selected[field], s)
+}
+
+// Selected returns the selections of the facet field in their URL form.
+func (n *Navigation) Selected(field string) []string {
+	return slices.Clone(n.selected[field])
+}
+
+// Clear removes all selections of the facet field.
+func (n *Navigation) Clear(field string) {
+	delete(n.selected, field)
+}
+
+// Reset removes all selections.
+func (n *Navigation) Reset() {
+	clear(n.selected)
+}
+
+// Query returns the selections as URL query parameters, one parameter per selection named after its facet field.
+func (n *Navigation) Query() url.Values {
+	q := url.Values{}
+	for _, f := range n.facets {
+		for _, s := range n.selected[f.expr.Name()] {
+			q.Add(f.expr.Name(), s)
+		}
+	}
+	return q
+}
+
+// SetQuery replaces the selections with those in q, as returned by Query. Parameters that
+// aren't facet fields, such as the search text or page, are ignored.
+func (n *Navigation) SetQuery(q url.Values) error {
+	n.Reset()
+	var errs []error
+	for _, f := range n.facets {
+		for _, s := range q[f.expr.Name()] {
+			if err := n.Select(f.expr.Name(), s); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Filter returns the filter of all selections, or nil if nothing is selected.
+func (n *Navigation) Filter() (odata.Expr, error) {
+	return n.filter("")
+}
+
+// filter returns the filter of the selections of all facets except exclude.
+func (n *Navigation) filter(exclude string) (odata.Expr, error) {
+	var exprs []odata.Expr
+	for _, f := range n.facets {
+		name := f.expr.Name()
+		if name == exclude || len(n.selected[name]) == 0 {
+			continue
+		}
+		e, err := f.filter(n.selected[name])
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+	}
+	if len(exprs) == 0 {
+		return nil, nil
+	}
+	return combine(odata.And, exprs), nil
+}
+
+// combine combines exprs with op, unless there is only one, which would be parenthesized needlessly.
+func combine(op func(...odata.Expr) odata.Expr, exprs []odata.Expr) odata.Expr {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	return op(exprs...)
+}
+
+// Request returns searchRequest with the facets of n added and the selections applied to its filter.
+// The facet counts of its response are conjunctive: they only count documents matching all
+// selections, including those of the facet itself. Use Search for disjunctive counts.
+func (n *Navigation) Request(searchRequest searchindex.SearchRequest) (searchindex.SearchRequest, error) {
+	return n.request(searchRequest, "", n.exprs())
+}
+
+func (n *Navigation) request(searchRequest searchindex.SearchRequest, exclude string, facets []Expr) (searchindex.SearchRequest, error) {
+	e, err := n.filter(exclude)
+	if err != nil {
+		return searchRequest, err
+	}
+	if e != nil {
+		if searchRequest.Filter != nil && *searchRequest.Filter != "" {
+			e = odata.And(odata.Raw("("+*searchRequest.Filter+")"), e)
+		}
+		filter, err := odata.Render(e)
+		if err != nil {
+			return searchRequest, err
+		}
+		searchRequest.Filter = &filter
+	}
+	searchRequest.Facets = append(slices.Clone(searchRequest.Facets), Pointers(facets...)...)
+	return searchRequest, nil
+}
+
+func (n *Navigation) exprs() []Expr {
+	exprs := make([]Expr, len(n.facets))
+	for i, f := range n.facets {
+		exprs[i] = f.expr
+	}
+	return exprs
+}
+
+// Search runs searchRequest with the selections applied and returns its response with disjunctive
+// facet counts: the buckets of a facet with selections count the documents matching the selections
+// of all other facets, so users can see how many results each additional value of that facet adds.
+// This takes one extra query per facet with selections, run concurrently with the main query.
+func (n *Navigation) Search(ctx context.Context, docs *searchindex.DocumentsClient, searchRequest searchindex.SearchRequest, requestOptions *searchindex.RequestOptions, options *searchindex.DocumentsClientSearchPostOptions) (searchindex.DocumentsClientSearchPostResponse, error) {
+	var unselected, selected []Expr
+	for _, f := range n.facets {
+		if len(n.selected[f.expr.Name()]) > 0 {
+			selected = append(selected, f.expr)
+		} else {
+			unselected = append(unselected, f.expr)
+		}
+	}
+
+	main, err := n.request(searchRequest, "", unselected)
+	if err != nil {
+		return searchindex.DocumentsClientSearchPostResponse{}, err
+	}
+	requests := []searchindex.SearchRequest{main}
+	for _, e := range selected {
+		req, err := n.request(facetOnly(searchRequest), e.Name(), []Expr{e})
+		if err != nil {
+			return searchindex.DocumentsClientSearchPostResponse{}, err
+		}
+		requests = append(requests, req)
+	}
+
+	responses := make([]searchindex.DocumentsClientSearchPostResponse, len(requests))
+	errs := make([]error, len(requests))
+	var wg sync.WaitGroup
+	for i, req := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[i], errs[i] = docs.SearchPost(ctx, req, requestOptions, options)
+		}()
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return searchindex.DocumentsClientSearchPostResponse{}, err
+	}
+
+	resp := responses[0]
+	facets := make(map[string][]*searchindex.FacetResult, len(n.facets))
+	for k, v := range resp.Facets {
+		facets[k] = v
+	}
+	for i, e := range selected {
+		facets[e.Name()] = responses[i+1].Facets[e.Name()]
+	}
+	resp.Facets = facets
+	return resp, nil
+}
+
+// facetOnly strips searchRequest down to what affects the matching documents, for a query that only returns facets.
+func facetOnly(searchRequest searchindex.SearchRequest) searchindex.SearchRequest {
+	searchRequest.Facets = nil
+	searchRequest.Top = ptr[int32](0)
+	searchRequest.Skip = nil
+	searchRequest.IncludeTotalResultCount = nil
+	searchRequest.OrderBy = nil
+	searchRequest.Select = nil
+	searchRequest.HighlightFields = nil
+	searchRequest.Answers = nil
+	searchRequest.Captions = nil
+	searchRequest.Debug = nil
+	return searchRequest
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// filter returns the filter of the selections of f.
+func (f navigationFacet) filter(selections []string) (odata.Expr, error) {
+	var values []any
+	var exprs []odata.Expr
+	for _, s := range selections {
+		from, to, isRange := f.splitRange(s)
+		if !isRange {
+			v, err := f.parse(s)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+			continue
+		}
+		var bounds []func(odata.FieldRef) odata.Expr
+		if from != "" {
+			v, err := f.parse(from)
+			if err != nil {
+				return nil, err
+			}
+			bounds = append(bounds, func(r odata.FieldRef) odata.Expr { return r.Ge(v) })
+		}
+		if to != "" {
+			v, err := f.parse(to)
+			if err != nil {
+				return nil, err
+			}
+			bounds = append(bounds, func(r odata.FieldRef) odata.Expr { return r.Lt(v) })
+		}
+		exprs = append(exprs, f.on(func(r odata.FieldRef) odata.Expr {
+			if len(bounds) == 0 {
+				return odata.Not(r.IsNull())
+			}
+			e := make([]odata.Expr, len(bounds))
+			for i, b := range bounds {
+				e[i] = b(r)
+			}
+			return combine(odata.And, e)
+		}))
+	}
+	if len(values) > 0 {
+		exprs = append(exprs, f.on(func(r odata.FieldRef) odata.Expr {
+			if f.typ == searchservice.SearchFieldDataTypeString && len(values) > 1 {
+				strs := make([]string, len(values))
+				for i, v := range values {
+					strs[i] = v.(string)
+				}
+				return odata.SearchIn(r, strs...)
+			}
+			e := make([]odata.Expr, len(values))
+			for i, v := range values {
+				e[i] = r.Eq(v)
+			}
+			return combine(odata.Or, e)
+		}))
+	}
+	return combine(odata.Or, exprs), nil
+}
+
+// on applies cond to the field of f, or to any of its elements if it is a collection.
+func (f navigationFacet) on(cond func(odata.FieldRef) odata.Expr) odata.Expr {
+	field := odata.Field(f.expr.Name())
+	if !f.collection {
+		return cond(field)
+	}
+	return field.Any(func(x odata.Var) odata.Expr { return cond(x.FieldRef) })
+}
+
+// ranged reports whether selections of f may be ranges.
+func (f navigationFacet) ranged() bool {
+	switch f.typ {
+	case searchservice.SearchFieldDataTypeInt32, searchservice.SearchFieldDataTypeInt64,
+		searchservice.SearchFieldDataTypeDouble, searchservice.SearchFieldDataTypeDateTimeOffset:
+		return true
+	}
+	return false
+}
+
+func (f navigationFacet) splitRange(s string) (from, to string, ok bool) {
+	if !f.ranged() {
+		return "", "", false
+	}
+	return strings.Cut(s, rangeSeparator)
+}
+
+// parse parses s, the URL form of a value of f, into a value of the field's type.
+func (f navigationFacet) parse(s string) (any, error) {
+	var v any
+	var err error
+	switch f.typ {
+	case searchservice.SearchFieldDataTypeString:
+		v = s
+	case searchservice.SearchFieldDataTypeBoolean:
+		v, err = strconv.ParseBool(s)
+	case searchservice.SearchFieldDataTypeInt32:
+		v, err = strconv.ParseInt(s, 10, 32)
+	case searchservice.SearchFieldDataTypeInt64:
+		v, err = strconv.ParseInt(s, 10, 64)
+	case searchservice.SearchFieldDataTypeDouble:
+		v, err = strconv.ParseFloat(s, 64)
+	case searchservice.SearchFieldDataTypeDateTimeOffset:
+		v, err = time.Parse(time.RFC3339Nano, s)
+	default:
+		return nil, fmt.Errorf("facet %q: fields of type %s are not supported", f.expr.Name(), f.typ)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("facet %q: invalid %s value %q", f.expr.Name(), f.typ, s)
+	}
+	return v, nil
+}
+
+// format returns the URL form of v, which must be valid for f.
+func (f navigationFacet) format(v any) (string, error) {
+	s, err := formatValue(v)
+	if err != nil {
+		return "", fmt.Errorf("facet %q: %w", f.expr.Name(), err)
+	}
+	if _, err := f.parse(s); err != nil {
+		return "", err
+	}
+	if f.typ == searchservice.SearchFieldDataTypeDateTimeOffset {
+		// normalize, e.g. offsets to UTC
+		t, _ := time.Parse(time.RFC3339Nano, s)
+		s = t.UTC().Format(time.RFC3339Nano)
+	}
+	return s, nil
+}
+
+// selection returns the URL form of a selection of the facet field.
+func (n *Navigation) selection(field string, value any) (string, error) {
+	f, err := n.lookup(field)
+	if err != nil {
+		return "", err
+	}
+	if r, ok := value.(rangeValue); ok {
+		if !f.ranged() {
+			return "", fmt.Errorf("facet %q: ranges are only supported for numeric and date fields", field)
+		}
+		var from, to string
+		if r.from != nil {
+			if from, err = f.format(r.from); err != nil {
+				return "", err
+			}
+		}
+		if r.to != nil {
+			if to, err = f.format(r.to); err != nil {
+				return "", err
+			}
+		}
+		return from + rangeSeparator + to, nil
+	}
+	if s, ok := value.(string); ok {
+		if from, to, isRange := f.splitRange(s); isRange {
+			return n.selection(field, rangeSelection(nilIfEmpty(from), nilIfEmpty(to)))
+		}
+	}
+	return f.format(value)
+}
+
+// rangeValue is a range selection passed from SelectRange to Select.
+type rangeValue struct {
+	from, to any
+}
+
+func rangeSelection(from, to any) rangeValue {
+	return rangeValue{from: deref(from), to: deref(to)}
+}
+
+func nilIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// deref returns the value pointed to by v, or nil for nil and nil pointers.
+func deref(v any) any {
+	rv := reflect.ValueOf(v)
+	for rv.IsValid() && rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+func formatValue(v any) (string, error) {
+	switch v := deref(v).(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano), nil
+	case nil:
+		return "", errors.New("value is required")
+	default:
+		return "", fmt.Errorf("unsupported value type %T", v)
+	}
+}
@@ -0,0 +1,119 @@
+package facet
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+	"sample-app/azaisearch/odata"
+)
+
+func testIndex() *searchservice.SearchIndex {
+	field := func(name string, typ searchservice.SearchFieldDataType) *searchservice.SearchField {
+		return &searchservice.SearchField{Name: ptr(name), Type: ptr(typ)}
+	}
+	return &searchservice.SearchIndex{Fields: []*searchservice.SearchField{
+		field("city", searchservice.SearchFieldDataTypeString),
+		field("tags", "Collection(Edm.String)"),
+		field("rating", searchservice.SearchFieldDataTypeDouble),
+	}}
+}
+
+func TestNavigationFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		apply func(n *Navigation) error
+		want  string
+	}{
+		{"nothing selected", func(n *Navigation) error { return nil }, ""},
+		{"single value", func(n *Navigation) error {
+			return n.Select("city", "New York")
+		}, "city eq 'New York'"},
+		{"values with spaces", func(n *Navigation) error {
+			if err := n.Select("city", "New York"); err != nil {
+				return err
+			}
+			return n.Select("city", "Los Angeles")
+		}, "search.in(city, 'New York,Los Angeles', ',')"},
+		{"values with commas", func(n *Navigation) error {
+			if err := n.Select("city", "Washington, D.C."); err != nil {
+				return err
+			}
+			return n.Select("city", "Boston")
+		}, "search.in(city, 'Washington, D.C.|Boston', '|')"},
+		{"collection", func(n *Navigation) error {
+			if err := n.Select("tags", "sea view"); err != nil {
+				return err
+			}
+			return n.Select("tags", "pool")
+		}, "tags/any(x0: search.in(x0, 'sea view,pool', ','))"},
+		{"range and facets combined", func(n *Navigation) error {
+			if err := n.SelectRange("rating", 3, nil); err != nil {
+				return err
+			}
+			return n.Select("city", "Boston")
+		}, "city eq 'Boston' and rating ge 3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := NewNavigation(testIndex(), Field("city"), Field("tags"), Field("rating").Values(3, 4))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.apply(n); err != nil {
+				t.Fatal(err)
+			}
+			e, err := n.Filter()
+			if err != nil {
+				t.Fatalf("Filter() error = %v", err)
+			}
+			got := ""
+			if e != nil {
+				if got, err = odata.Render(e); err != nil {
+					t.Fatalf("Render() error = %v", err)
+				}
+			}
+			if got != tt.want {
+				t.Errorf("filter = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNavigationQuery(t *testing.T) {
+	n, err := NewNavigation(testIndex(), Field("city"), Field("rating"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Select("city", "New York"); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.SelectRange("rating", 2.5, 4); err != nil {
+		t.Fatal(err)
+	}
+	q := n.Query()
+	want := url.Values{"city": {"New York"}, "rating": {"2.5..4"}}
+	if !reflect.DeepEqual(q, want) {
+		t.Fatalf("Query() = %v, want %v", q, want)
+	}
+
+	restored, err := NewNavigation(testIndex(), Field("city"), Field("rating"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := restored.SetQuery(url.Values{"city": {"New York"}, "rating": {"2.5..4"}, "page": {"2"}}); err != nil {
+		t.Fatalf("SetQuery() error = %v", err)
+	}
+	if !restored.IsSelected("city", "New York") || !restored.IsSelected("rating", rangeSelection(2.5, 4)) {
+		t.Errorf("SetQuery() selections = %v", restored.Query())
+	}
+}
+
+func TestNewNavigationErrors(t *testing.T) {
+	index := testIndex()
+	index.Fields[0].Facetable = ptr(false)
+	if _, err := NewNavigation(index, Field("city"), Field("missing")); err == nil {
+		t.Error("NewNavigation() succeeded for a non-facetable and a missing field")
+	}
+}
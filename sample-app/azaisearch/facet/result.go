@@ -0,0 +1,97 @@
+package facet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+// ValueBucket is a bucket of a value facet, or of an interval facet where Value is the bucket's lower bound.
+type ValueBucket[T any] struct {
+	Value T
+	Count int64
+}
+
+// RangeBucket is a bucket of a facet with Values or DateValues. From is inclusive and To is
+// exclusive; either is nil for the open-ended first and last bucket.
+type RangeBucket[T any] struct {
+	From  *T
+	To    *T
+	Count int64
+}
+
+// IsRange reports whether r is a range bucket (it has from or to) rather than a value bucket.
+func IsRange(r *searchindex.FacetResult) bool {
+	if r == nil {
+		return false
+	}
+	_, from := r.AdditionalProperties["from"]
+	_, to := r.AdditionalProperties["to"]
+	return from || to
+}
+
+// ValueBuckets decodes value or interval facet results into buckets of type T, e.g. string for
+// Edm.String, float64 or int64 for numeric fields and time.Time for Edm.DateTimeOffset fields.
+func ValueBuckets[T any](results []*searchindex.FacetResult) ([]ValueBucket[T], error) {
+	buckets := make([]ValueBucket[T], 0, len(results))
+	for i, r := range results {
+		if r == nil {
+			continue
+		}
+		b := ValueBucket[T]{Count: count(r)}
+		raw, ok := r.AdditionalProperties["value"]
+		if !ok {
+			return nil, fmt.Errorf("facet result %d has no value", i)
+		}
+		if err := convert(raw, &b.Value); err != nil {
+			return nil, fmt.Errorf("facet result %d: %w", i, err)
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, nil
+}
+
+// RangeBuckets decodes range facet results into buckets of type T, see ValueBuckets.
+func RangeBuckets[T any](results []*searchindex.FacetResult) ([]RangeBucket[T], error) {
+	buckets := make([]RangeBucket[T], 0, len(results))
+	for i, r := range results {
+		if r == nil {
+			continue
+		}
+		b := RangeBucket[T]{Count: count(r)}
+		if raw, ok := r.AdditionalProperties["from"]; ok && raw != nil {
+			b.From = new(T)
+			if err := convert(raw, b.From); err != nil {
+				return nil, fmt.Errorf("facet result %d: from: %w", i, err)
+			}
+		}
+		if raw, ok := r.AdditionalProperties["to"]; ok && raw != nil {
+			b.To = new(T)
+			if err := convert(raw, b.To); err != nil {
+				return nil, fmt.Errorf("facet result %d: to: %w", i, err)
+			}
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, nil
+}
+
+func count(r *searchindex.FacetResult) int64 {
+	if r.Count == nil {
+		return 0
+	}
+	return *r.Count
+}
+
+// convert converts a value decoded from JSON into out by re-encoding it.
+func convert(v any, out any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("cannot convert %v to %T: %w", v, out, err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package facet
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+func TestValueBuckets(t *testing.T) {
+	results := []*searchindex.FacetResult{
+		{Count: ptr(int64(3)), AdditionalProperties: map[string]any{"value": "2024-01-01T00:00:00Z"}},
+		nil,
+		{Count: ptr(int64(1)), AdditionalProperties: map[string]any{"value": "2024-02-01T00:00:00Z"}},
+	}
+	got, err := ValueBuckets[time.Time](results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ValueBucket[time.Time]{
+		{Value: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Count: 3},
+		{Value: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Count: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValueBuckets() = %v, want %v", got, want)
+	}
+
+	if _, err := ValueBuckets[int64]([]*searchindex.FacetResult{{AdditionalProperties: map[string]any{"value": "x"}}}); err == nil {
+		t.Error("ValueBuckets() succeeded for a value of the wrong type")
+	}
+}
+
+func TestRangeBuckets(t *testing.T) {
+	results := []*searchindex.FacetResult{
+		{Count: ptr(int64(2)), AdditionalProperties: map[string]any{"to": 3.0}},
+		{Count: ptr(int64(5)), AdditionalProperties: map[string]any{"from": 3.0, "to": 4.0}},
+		{Count: ptr(int64(1)), AdditionalProperties: map[string]any{"from": 4.0}},
+	}
+	for i, r := range results {
+		if !IsRange(r) {
+			t.Errorf("IsRange(results[%d]) = false", i)
+		}
+	}
+	got, err := RangeBuckets[float64](results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []RangeBucket[float64]{
+		{To: ptr(3.0), Count: 2},
+		{From: ptr(3.0), To: ptr(4.0), Count: 5},
+		{From: ptr(4.0), Count: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RangeBuckets() = %v, want %v", got, want)
+	}
+}
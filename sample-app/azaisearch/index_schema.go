@@ -0,0 +1,296 @@
+package azaisearch
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+	"sample-app/azaisearch/odata"
+)
+
+// Index fields are derived from Go structs with FieldsFor and IndexFor. The search tag of a struct
+// field sets its attributes:
+//
+//	type Hotel struct {
+//		ID       string    `json:"id" search:"key,filterable"`
+//		Name     string    `json:"name" search:"searchable,sortable,analyzer=en.microsoft"`
+//		Tags     []string  `json:"tags" search:"filterable,facetable"`
+//		Address  Address   `json:"address"`
+//		Vector   []float32 `json:"vector" search:"vector=1536,profile=hnsw"`
+//	}
+//
+// Boolean attributes are set with a flag (e.g. "filterable") or an explicit value (e.g.
+// "retrievable=false"); attributes that aren't mentioned are left to the service defaults.
+//
+//	key, searchable, filterable, sortable, facetable, retrievable, stored
+//	hidden                      shorthand for retrievable=false
+//	name=...                    the index field name, see the document mapping
+//	analyzer=..., searchAnalyzer=..., indexAnalyzer=..., normalizer=...
+//	synonymMaps=a|b             synonym maps, separated by '|'
+//	vector=N, profile=...       vector dimensions and vector search profile
+//	type=...                    overrides the Edm type, e.g. type=Collection(Edm.Half)
+//	-                           excludes the field from the index
+//
+// Field types are derived from Go types: string is Edm.String, bool Edm.Boolean, int8 to int32,
+// uint8 and uint16 Edm.Int32, int, int64 and uint32 Edm.Int64, floats Edm.Double, time.Time
+// Edm.DateTimeOffset, odata.GeoPoint Edm.GeographyPoint, and structs Edm.ComplexType with
+// subfields. uint and uint64 are rejected, as their values may not fit in Edm.Int64. Slices are
+// collections of their element type. Fields with the vector option are vectors instead: []float32
+// is Collection(Edm.Single), []int16 Collection(Edm.Int16) and []int8 Collection(Edm.SByte).
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	geoPointType = reflect.TypeFor[odata.GeoPoint]()
+)
+
+// IndexFor returns the definition of an index named name whose fields are derived from the struct
+// type T, see FieldsFor. Other settings, such as vector search profiles, must be added to it.
+func IndexFor[T any](name string) (*searchservice.SearchIndex, error) {
+	fields, err := FieldsFor[T]()
+	if err != nil {
+		return nil, err
+	}
+	return &searchservice.SearchIndex{Name: &name, Fields: fields}, nil
+}
+
+// FieldsFor returns the index fields derived from the struct type T and its search tags.
+// Exactly one field must be tagged as key, and it must be a string.
+func FieldsFor[T any]() ([]*searchservice.SearchField, error) {
+	t := reflect.TypeFor[T]()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a struct type", t)
+	}
+	fields, err := searchFields(t, "", map[reflect.Type]bool{t: true})
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, f := range fields {
+		if f.Key != nil && *f.Key {
+			keys = append(keys, *f.Name)
+			if *f.Type != searchservice.SearchFieldDataTypeString {
+				return nil, fmt.Errorf("key field %q must be of type %s", *f.Name, searchservice.SearchFieldDataTypeString)
+			}
+		}
+	}
+	if len(keys) != 1 {
+		return nil, fmt.Errorf("%s must have exactly one key field, found %d", t, len(keys))
+	}
+	return fields, nil
+}
+
+// searchFields returns the index fields of struct type t. path is the path of t's parent field, and
+// parents holds the struct types being expanded, to reject recursive types.
+func searchFields(t reflect.Type, path string, parents map[reflect.Type]bool) ([]*searchservice.SearchField, error) {
+	var fields []*searchservice.SearchField
+	var errs []error
+	for _, df := range documentFields(t) {
+		if slices.Contains(df.options, "-") {
+			continue
+		}
+		fieldPath := df.indexName
+		if path != "" {
+			fieldPath = path + "/" + df.indexName
+		}
+		f, err := searchField(df, fieldPath, parents)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if path != "" && f.Key != nil && *f.Key {
+			errs = append(errs, fmt.Errorf("field %q: subfields of complex fields cannot be keys", fieldPath))
+		}
+		fields = append(fields, f)
+	}
+	return fields, errors.Join(errs...)
+}
+
+func searchField(df documentField, path string, parents map[reflect.Type]bool) (*searchservice.SearchField, error) {
+	f := &searchservice.SearchField{Name: &df.indexName}
+	typ, elem, err := edmType(df.typ)
+	if vt, ok := vectorType(df.typ); ok {
+		if _, vector := df.options.value("vector"); vector {
+			typ, err = vt, nil
+		}
+	}
+	if override, ok := df.options.value("type"); ok {
+		typ, err = searchservice.SearchFieldDataType(override), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("field %q: %w", path, err)
+	}
+	f.Type = &typ
+
+	if elemType, _ := schema.ElementType(typ); elemType == searchservice.SearchFieldDataTypeComplex {
+		if len(df.options) > 0 && !(len(df.options) == 1 && strings.HasPrefix(df.options[0], "name=")) {
+			return nil, fmt.Errorf("field %q: complex fields cannot have attributes", path)
+		}
+		if parents[elem] {
+			return nil, fmt.Errorf("field %q: recursive type %s", path, elem)
+		}
+		parents[elem] = true
+		defer delete(parents, elem)
+		if f.Fields, err = searchFields(elem, path, parents); err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+
+	if err := applyTagOptions(f, df.options); err != nil {
+		return nil, fmt.Errorf("field %q: %w", path, err)
+	}
+	return f, nil
+}
+
+func applyTagOptions(f *searchservice.SearchField, opts tagOptions) error {
+	flags := map[string]**bool{
+		"key":         &f.Key,
+		"searchable":  &f.Searchable,
+		"filterable":  &f.Filterable,
+		"sortable":    &f.Sortable,
+		"facetable":   &f.Facetable,
+		"retrievable": &f.Retrievable,
+		"stored":      &f.Stored,
+	}
+	for _, opt := range opts {
+		name, value, hasValue := strings.Cut(opt, "=")
+		if dst, ok := flags[name]; ok {
+			b, err := opts.flag(name)
+			if err != nil {
+				return err
+			}
+			*dst = b
+			continue
+		}
+		switch {
+		case name == "hidden" && !hasValue:
+			f.Retrievable = ptr(false)
+		case name == "name" || name == "type":
+			// handled by documentFields and searchField
+		case name == "analyzer":
+			f.Analyzer = ptr(searchservice.LexicalAnalyzerName(value))
+		case name == "searchAnalyzer":
+			f.SearchAnalyzer = ptr(searchservice.LexicalAnalyzerName(value))
+		case name == "indexAnalyzer":
+			f.IndexAnalyzer = ptr(searchservice.LexicalAnalyzerName(value))
+		case name == "normalizer":
+			f.Normalizer = ptr(searchservice.LexicalNormalizerName(value))
+		case name == "synonymMaps":
+			for _, m := range strings.Split(value, "|") {
+				f.SynonymMaps = append(f.SynonymMaps, ptr(m))
+			}
+		case name == "vector":
+			dims, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || dims <= 0 {
+				return fmt.Errorf("invalid vector dimensions %q", value)
+			}
+			f.VectorSearchDimensions = ptr(int32(dims))
+		case name == "profile":
+			f.VectorSearchProfileName = ptr(value)
+		default:
+			return fmt.Errorf("unknown search tag option %q", opt)
+		}
+	}
+
+	if f.VectorSearchDimensions != nil {
+		elem, collection := schema.ElementType(*f.Type)
+		switch {
+		case !collection || !isVectorElement(elem):
+			return fmt.Errorf("vector fields must be collections of Edm.Single, Edm.Half, Edm.Int16, Edm.SByte or Edm.Byte, got %s", *f.Type)
+		case f.VectorSearchProfileName == nil:
+			return errors.New("vector fields need a profile")
+		}
+		if f.Searchable == nil {
+			f.Searchable = ptr(true)
+		}
+	}
+	return nil
+}
+
+func isVectorElement(t searchservice.SearchFieldDataType) bool {
+	switch t {
+	case searchservice.SearchFieldDataTypeSingle, searchservice.SearchFieldDataTypeHalf, searchservice.SearchFieldDataTypeInt16,
+		searchservice.SearchFieldDataTypeSByte, searchservice.SearchFieldDataTypeByte:
+		return true
+	}
+	return false
+}
+
+// edmType returns the Edm type of Go type t, and for complex types the struct type of their subfields.
+func edmType(t reflect.Type) (searchservice.SearchFieldDataType, reflect.Type, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType:
+		return searchservice.SearchFieldDataTypeDateTimeOffset, nil, nil
+	case geoPointType:
+		return searchservice.SearchFieldDataTypeGeographyPoint, nil, nil
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return searchservice.SearchFieldDataTypeString, nil, nil
+	case reflect.Bool:
+		return searchservice.SearchFieldDataTypeBoolean, nil, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return searchservice.SearchFieldDataTypeInt32, nil, nil
+	case reflect.Int, reflect.Int64, reflect.Uint32:
+		return searchservice.SearchFieldDataTypeInt64, nil, nil
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return "", nil, fmt.Errorf("cannot map %s to Edm.Int64, which can't hold all its values; use int64 or set the type option", t)
+	case reflect.Float32, reflect.Float64:
+		return searchservice.SearchFieldDataTypeDouble, nil, nil
+	case reflect.Struct:
+		if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+			return "", nil, fmt.Errorf("cannot derive the Edm type of %s, which has custom JSON encoding; set it with the type option", t)
+		}
+		return searchservice.SearchFieldDataTypeComplex, t, nil
+	case reflect.Slice, reflect.Array:
+		elem := t.Elem()
+		if t.Kind() == reflect.Slice && elem.Kind() == reflect.Uint8 {
+			// encoding/json writes []byte as a base64 string
+			return "", nil, fmt.Errorf("cannot map %s to a collection; use []int16 or set the type option", t)
+		}
+		elemType, complexType, err := edmType(elem)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, nested := schema.ElementType(elemType); nested {
+			return "", nil, fmt.Errorf("nested collections are not supported")
+		}
+		return schema.Collection(elemType), complexType, nil
+	}
+	return "", nil, fmt.Errorf("cannot map Go type %s to an Edm type", t)
+}
+
+// vectorType returns the Edm type of a vector field of Go type t, and whether t can be a vector.
+func vectorType(t reflect.Type) (searchservice.SearchFieldDataType, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return "", false
+	}
+	switch t.Elem().Kind() {
+	case reflect.Float32:
+		return schema.Collection(searchservice.SearchFieldDataTypeSingle), true
+	case reflect.Int16:
+		return schema.Collection(searchservice.SearchFieldDataTypeInt16), true
+	case reflect.Int8:
+		return schema.Collection(searchservice.SearchFieldDataTypeSByte), true
+	}
+	return "", false
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,245 @@
+package azaisearch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+	"sample-app/azaisearch/odata"
+)
+
+func TestFieldsForTypes(t *testing.T) {
+	type room struct {
+		Beds int32 `json:"beds"`
+	}
+	type doc struct {
+		ID        string            `json:"id" search:"key"`
+		Flag      bool              `json:"flag"`
+		I8        int8              `json:"i8"`
+		I16       int16             `json:"i16"`
+		I32       int32             `json:"i32"`
+		I         int               `json:"i"`
+		I64       *int64            `json:"i64"`
+		U8        uint8             `json:"u8"`
+		U16       uint16            `json:"u16"`
+		U32       uint32            `json:"u32"`
+		F32       float32           `json:"f32"`
+		F64       float64           `json:"f64"`
+		Time      time.Time         `json:"time"`
+		Location  odata.GeoPoint    `json:"location"`
+		Tags      []string          `json:"tags"`
+		Floats    []float32         `json:"floats"`
+		Shorts    []int16           `json:"shorts"`
+		Bytes     []int8            `json:"bytes"`
+		Array     [4]uint8          `json:"array"`
+		Single    []float32         `json:"single" search:"vector=3,profile=p"`
+		Int16     []int16           `json:"int16" search:"vector=3,profile=p"`
+		SByte     [3]int8           `json:"sbyte" search:"vector=3,profile=p"`
+		Half      []float32         `json:"half" search:"vector=3,profile=p,type=Collection(Edm.Half)"`
+		Override  string            `json:"override" search:"type=Edm.Int64"`
+		Room      room              `json:"room"`
+		Rooms     []room            `json:"rooms"`
+		Renamed   string            `json:"renamed" search:"name=otherName"`
+		Skipped   string            `json:"skipped" search:"-"`
+		Ignored   string            `json:"-"`
+		Timestamp *time.Time        `json:"timestamp"`
+		Points    []*odata.GeoPoint `json:"points"`
+	}
+	fields, err := FieldsFor[doc]()
+	if err != nil {
+		t.Fatalf("FieldsFor() error = %v", err)
+	}
+	got := map[string]string{}
+	for _, f := range fields {
+		got[*f.Name] = string(*f.Type)
+		for _, sf := range f.Fields {
+			got[*f.Name+"/"+*sf.Name] = string(*sf.Type)
+		}
+	}
+	want := map[string]string{
+		"id":         "Edm.String",
+		"flag":       "Edm.Boolean",
+		"i8":         "Edm.Int32",
+		"i16":        "Edm.Int32",
+		"i32":        "Edm.Int32",
+		"i":          "Edm.Int64",
+		"i64":        "Edm.Int64",
+		"u8":         "Edm.Int32",
+		"u16":        "Edm.Int32",
+		"u32":        "Edm.Int64",
+		"f32":        "Edm.Double",
+		"f64":        "Edm.Double",
+		"time":       "Edm.DateTimeOffset",
+		"location":   "Edm.GeographyPoint",
+		"tags":       "Collection(Edm.String)",
+		"floats":     "Collection(Edm.Double)",
+		"shorts":     "Collection(Edm.Int32)",
+		"bytes":      "Collection(Edm.Int32)",
+		"array":      "Collection(Edm.Int32)",
+		"single":     "Collection(Edm.Single)",
+		"int16":      "Collection(Edm.Int16)",
+		"sbyte":      "Collection(Edm.SByte)",
+		"half":       "Collection(Edm.Half)",
+		"override":   "Edm.Int64",
+		"room":       "Edm.ComplexType",
+		"room/beds":  "Edm.Int32",
+		"rooms":      "Collection(Edm.ComplexType)",
+		"rooms/beds": "Edm.Int32",
+		"otherName":  "Edm.String",
+		"timestamp":  "Edm.DateTimeOffset",
+		"points":     "Collection(Edm.GeographyPoint)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		for name, typ := range want {
+			if got[name] != typ {
+				t.Errorf("FieldsFor() field %q type = %q, want %q", name, got[name], typ)
+			}
+		}
+		for name := range got {
+			if _, ok := want[name]; !ok {
+				t.Errorf("FieldsFor() has unexpected field %q", name)
+			}
+		}
+	}
+}
+
+func TestFieldsForTags(t *testing.T) {
+	type doc struct {
+		ID      string    `json:"id" search:"key,filterable"`
+		Name    string    `json:"name" search:"searchable,sortable=false,analyzer=en.microsoft"`
+		Secret  string    `json:"secret" search:"hidden,stored=false"`
+		Code    string    `json:"code" search:" facetable , normalizer=lowercase"`
+		Terms   string    `json:"terms" search:"searchAnalyzer=a,indexAnalyzer=b,synonymMaps=s1|s2"`
+		Vector  []float32 `json:"vector" search:"vector=3,profile=hnsw"`
+		Unset   string    `json:"unset"`
+		Default string    `json:"default" search:"retrievable=true"`
+	}
+	fields, err := FieldsFor[doc]()
+	if err != nil {
+		t.Fatalf("FieldsFor() error = %v", err)
+	}
+	want := []*searchservice.SearchField{
+		{Name: ptr("id"), Type: ptr(searchservice.SearchFieldDataTypeString), Key: ptr(true), Filterable: ptr(true)},
+		{Name: ptr("name"), Type: ptr(searchservice.SearchFieldDataTypeString), Searchable: ptr(true), Sortable: ptr(false), Analyzer: ptr(searchservice.LexicalAnalyzerName("en.microsoft"))},
+		{Name: ptr("secret"), Type: ptr(searchservice.SearchFieldDataTypeString), Retrievable: ptr(false), Stored: ptr(false)},
+		{Name: ptr("code"), Type: ptr(searchservice.SearchFieldDataTypeString), Facetable: ptr(true), Normalizer: ptr(searchservice.LexicalNormalizerName("lowercase"))},
+		{Name: ptr("terms"), Type: ptr(searchservice.SearchFieldDataTypeString), SearchAnalyzer: ptr(searchservice.LexicalAnalyzerName("a")), IndexAnalyzer: ptr(searchservice.LexicalAnalyzerName("b")), SynonymMaps: []*string{ptr("s1"), ptr("s2")}},
+		{Name: ptr("vector"), Type: ptr(searchservice.SearchFieldDataType("Collection(Edm.Single)")), Searchable: ptr(true), VectorSearchDimensions: ptr(int32(3)), VectorSearchProfileName: ptr("hnsw")},
+		{Name: ptr("unset"), Type: ptr(searchservice.SearchFieldDataTypeString)},
+		{Name: ptr("default"), Type: ptr(searchservice.SearchFieldDataTypeString), Retrievable: ptr(true)},
+	}
+	if len(fields) != len(want) {
+		t.Fatalf("FieldsFor() returned %d fields, want %d", len(fields), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(fields[i], want[i]) {
+			t.Errorf("FieldsFor() field %d = %+v, want %+v", i, *fields[i], *want[i])
+		}
+	}
+}
+
+type recursiveDoc struct {
+	ID     string        `json:"id" search:"key"`
+	Parent *recursiveDoc `json:"parent"`
+}
+
+func TestFieldsForErrors(t *testing.T) {
+	type sub struct {
+		ID string `json:"id" search:"key"`
+	}
+	tests := []struct {
+		name    string
+		fields  func() ([]*searchservice.SearchField, error)
+		wantErr string
+	}{
+		{"not a struct", FieldsFor[string], "is not a struct type"},
+		{"no key", FieldsFor[struct {
+			ID string `json:"id"`
+		}], "exactly one key field, found 0"},
+		{"two keys", FieldsFor[struct {
+			A string `json:"a" search:"key"`
+			B string `json:"b" search:"key"`
+		}], "exactly one key field, found 2"},
+		{"non-string key", FieldsFor[struct {
+			ID int `json:"id" search:"key"`
+		}], "must be of type Edm.String"},
+		{"uint64", FieldsFor[struct {
+			ID string `json:"id" search:"key"`
+			N  uint64 `json:"n"`
+		}], `field "n": cannot map uint64 to Edm.Int64`},
+		{"uint", FieldsFor[struct {
+			ID string `json:"id" search:"key"`
+			N  uint   `json:"n"`
+		}], `field "n": cannot map uint to Edm.Int64`},
+		{"byte slice", FieldsFor[struct {
+			ID string `json:"id" search:"key"`
+			B  []byte `json:"b"`
+		}], `field "b": cannot map []uint8 to a collection`},
+		{"unsupported type", FieldsFor[struct {
+			ID string         `json:"id" search:"key"`
+			M  map[string]int `json:"m"`
+		}], `field "m": cannot map Go type map[string]int`},
+		{"nested collection", FieldsFor[struct {
+			ID string     `json:"id" search:"key"`
+			N  [][]string `json:"n"`
+		}], "nested collections are not supported"},
+		{"float64 vector", FieldsFor[struct {
+			ID string    `json:"id" search:"key"`
+			V  []float64 `json:"v" search:"vector=3,profile=p"`
+		}], "vector fields must be collections of"},
+		{"scalar vector", FieldsFor[struct {
+			ID string  `json:"id" search:"key"`
+			V  float32 `json:"v" search:"vector=3,profile=p"`
+		}], "vector fields must be collections of"},
+		{"vector without profile", FieldsFor[struct {
+			ID string    `json:"id" search:"key"`
+			V  []float32 `json:"v" search:"vector=3"`
+		}], "vector fields need a profile"},
+		{"invalid dimensions", FieldsFor[struct {
+			ID string    `json:"id" search:"key"`
+			V  []float32 `json:"v" search:"vector=0,profile=p"`
+		}], `invalid vector dimensions "0"`},
+		{"invalid flag", FieldsFor[struct {
+			ID string `json:"id" search:"key,filterable=maybe"`
+		}], `invalid value "maybe" for search tag option filterable`},
+		{"unknown option", FieldsFor[struct {
+			ID string `json:"id" search:"key,fuzzy"`
+		}], `unknown search tag option "fuzzy"`},
+		{"complex with attributes", FieldsFor[struct {
+			ID  string `json:"id" search:"key"`
+			Sub sub    `json:"sub" search:"filterable"`
+		}], "complex fields cannot have attributes"},
+		{"key in subfield", FieldsFor[struct {
+			ID  string `json:"id" search:"key"`
+			Sub sub    `json:"sub"`
+		}], `field "sub/id": subfields of complex fields cannot be keys`},
+		{"recursive type", FieldsFor[recursiveDoc], `field "parent": recursive type`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.fields()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("FieldsFor() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIndexFor(t *testing.T) {
+	type hotel struct {
+		ID   string `json:"id" search:"key"`
+		Name string `json:"name" search:"searchable"`
+	}
+	index, err := IndexFor[hotel]("hotels")
+	if err != nil {
+		t.Fatalf("IndexFor() error = %v", err)
+	}
+	if *index.Name != "hotels" || len(index.Fields) != 2 || *index.Fields[1].Name != "name" {
+		t.Errorf("IndexFor() = %+v", index)
+	}
+	if _, err := IndexFor[struct{ Name string }]("hotels"); err == nil {
+		t.Error("IndexFor() without key field error = nil")
+	}
+}
@@ -0,0 +1,56 @@
+package azaisearch
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+type IndexersClientOptions struct {
+	azcore.ClientOptions
+
+	// Audience overrides the token audience derived from ClientOptions.Cloud, e.g. for
+	// sovereign or private clouds. Setting it also disables endpoint validation.
+	Audience string
+}
+
+// NewIndexersClient creates a new instance of IndexersClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - client options, pass nil to accept the default values.
+func NewIndexersClient(endpoint string, cred azcore.TokenCredential, options *IndexersClientOptions) (*searchservice.IndexersClient, error) {
+	if options == nil {
+		options = &IndexersClientOptions{}
+	}
+
+	authPolicy, err := newBearerTokenPolicy(endpoint, cred, options.Cloud, options.Audience)
+	if err != nil {
+		return nil, err
+	}
+	return newIndexersClient(endpoint, authPolicy, options)
+}
+
+// NewIndexersClientWithSharedKey creates a new instance of IndexersClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - keyCred - used to authorize requests with a shared key
+//   - options - client options, pass nil to accept the default values.
+func NewIndexersClientWithSharedKey(endpoint string, keyCred *azcore.KeyCredential, options *IndexersClientOptions) (*searchservice.IndexersClient, error) {
+
+	authPolicy := runtime.NewKeyCredentialPolicy(keyCred, "api-key", &runtime.KeyCredentialPolicyOptions{})
+	return newIndexersClient(endpoint, authPolicy, options)
+}
+
+func newIndexersClient(endpoint string, authPolicy policy.Policy, options *IndexersClientOptions) (*searchservice.IndexersClient, error) {
+	if options == nil {
+		options = &IndexersClientOptions{}
+	}
+
+	c, err := newCoreClient(authPolicy, &options.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchservice.NewIndexersClient(endpoint, c)
+}
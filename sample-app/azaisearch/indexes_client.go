@@ -5,12 +5,15 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 
-	"sample-app/azaisearch/internal"
 	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
 )
 
 type IndexesClientOptions struct {
 	azcore.ClientOptions
+
+	// Audience overrides the token audience derived from ClientOptions.Cloud, e.g. for
+	// sovereign or private clouds. Setting it also disables endpoint validation.
+	Audience string
 }
 
 // NewIndexesClient creates a new instance of IndexesClient with the specified values.
@@ -18,8 +21,14 @@ type IndexesClientOptions struct {
 //   - credential - used to authorize requests. Usually a credential from azidentity.
 //   - options - client options, pass nil to accept the default values.
 func NewIndexesClient(endpoint string, cred azcore.TokenCredential, options *IndexesClientOptions) (*searchservice.IndexesClient, error) {
+	if options == nil {
+		options = &IndexesClientOptions{}
+	}
 
-	authPolicy := runtime.NewBearerTokenPolicy(cred, []string{internal.TokenScope}, &policy.BearerTokenOptions{})
+	authPolicy, err := newBearerTokenPolicy(endpoint, cred, options.Cloud, options.Audience)
+	if err != nil {
+		return nil, err
+	}
 	return newIndexesClient(endpoint, authPolicy, options)
 }
 
@@ -38,10 +47,7 @@ func newIndexesClient(endpoint string, authPolicy policy.Policy, options *Indexe
 		options = &IndexesClientOptions{}
 	}
 
-	c, err := azcore.NewClient(moduleName, moduleVersion, runtime.PipelineOptions{
-		PerRetry: []policy.Policy{authPolicy},
-	}, &options.ClientOptions)
-
+	c, err := newCoreClient(authPolicy, &options.ClientOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,196 @@
+// Command genmodels generates the type aliases, constants and helper functions that
+// re-export the generated searchindex and searchservice packages from azaisearch.
+//
+// It is run through go generate from the azaisearch package directory:
+//
+//	//go:generate go run ./internal/cmd/genmodels
+//
+// Names declared by both packages are exported unchanged from searchservice and
+// with a "Documents" prefix from searchindex (e.g. DocumentsRequestOptions).
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const collisionPrefix = "Documents"
+
+// pkg describes one generated package to re-export.
+type pkg struct {
+	name   string
+	dir    string
+	types  []string
+	consts []constDecl
+	funcs  []string
+}
+
+type constDecl struct {
+	typ   string
+	names []string
+}
+
+func main() {
+	version := flag.String("version", "2025-09-01", "API version of the generated packages to re-export")
+	out := flag.String("out", "models_gen.go", "output file")
+	flag.Parse()
+
+	src, err := generate(".", *version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the source of the re-exports of the packages of version, which are looked up
+// relative to root, the azaisearch package directory.
+func generate(root, version string) ([]byte, error) {
+	base := filepath.Join(root, "internal", "services", "search", version)
+	service, err := load("searchservice", filepath.Join(base, "searchservice"))
+	if err != nil {
+		return nil, err
+	}
+	index, err := load("searchindex", filepath.Join(base, "searchindex"))
+	if err != nil {
+		return nil, err
+	}
+
+	declared := map[string]bool{}
+	for _, n := range service.names() {
+		declared[n] = true
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genmodels. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package azaisearch\n\n")
+	fmt.Fprintf(&buf, "import (\n")
+	fmt.Fprintf(&buf, "\t%q\n", "sample-app/azaisearch/internal/services/search/"+version+"/searchindex")
+	fmt.Fprintf(&buf, "\t%q\n", "sample-app/azaisearch/internal/services/search/"+version+"/searchservice")
+	fmt.Fprintf(&buf, ")\n\n")
+
+	service.write(&buf, func(n string) string { return n })
+	index.write(&buf, func(n string) string {
+		if declared[n] {
+			return collisionPrefix + n
+		}
+		return n
+	})
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w", err)
+	}
+	return src, nil
+}
+
+// load collects the exported declarations of the package in dir. Constructors are
+// skipped, since azaisearch provides its own.
+func load(name string, dir string) (*pkg, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	p := &pkg{name: name, dir: dir}
+	for _, astPkg := range pkgs {
+		// Files is a map; walk it in file name order so the output is stable
+		for _, filename := range slices.Sorted(maps.Keys(astPkg.Files)) {
+			for _, decl := range astPkg.Files[filename].Decls {
+				switch d := decl.(type) {
+				case *ast.GenDecl:
+					p.addGenDecl(d)
+				case *ast.FuncDecl:
+					if d.Recv == nil && d.Name.IsExported() && !strings.HasPrefix(d.Name.Name, "New") {
+						p.funcs = append(p.funcs, d.Name.Name)
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(p.types)
+	sort.Strings(p.funcs)
+	sort.SliceStable(p.consts, func(i, j int) bool {
+		a, b := p.consts[i], p.consts[j]
+		if a.typ != b.typ {
+			return a.typ < b.typ
+		}
+		return a.names[0] < b.names[0]
+	})
+	return p, nil
+}
+
+func (p *pkg) addGenDecl(d *ast.GenDecl) {
+	switch d.Tok {
+	case token.TYPE:
+		for _, spec := range d.Specs {
+			if ts := spec.(*ast.TypeSpec); ts.Name.IsExported() {
+				p.types = append(p.types, ts.Name.Name)
+			}
+		}
+	case token.CONST:
+		var c constDecl
+		for _, spec := range d.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if ident, ok := vs.Type.(*ast.Ident); ok {
+				c.typ = ident.Name
+			}
+			for _, n := range vs.Names {
+				if n.IsExported() {
+					c.names = append(c.names, n.Name)
+				}
+			}
+		}
+		if len(c.names) > 0 {
+			p.consts = append(p.consts, c)
+		}
+	}
+}
+
+func (p *pkg) names() []string {
+	names := append([]string{}, p.types...)
+	names = append(names, p.funcs...)
+	for _, c := range p.consts {
+		names = append(names, c.names...)
+	}
+	return names
+}
+
+func (p *pkg) write(buf *bytes.Buffer, rename func(string) string) {
+	fmt.Fprintf(buf, "// Types re-exported from %s.\n", p.name)
+	for _, t := range p.types {
+		fmt.Fprintf(buf, "type %s = %s.%s\n", rename(t), p.name, t)
+	}
+	buf.WriteString("\n")
+
+	for _, c := range p.consts {
+		if c.typ != "" {
+			fmt.Fprintf(buf, "// %s values re-exported from %s.\n", c.typ, p.name)
+		}
+		buf.WriteString("const (\n")
+		for _, n := range c.names {
+			fmt.Fprintf(buf, "\t%s = %s.%s\n", rename(n), p.name, n)
+		}
+		buf.WriteString(")\n\n")
+	}
+
+	for _, f := range p.funcs {
+		fmt.Fprintf(buf, "// %s re-exports %s.%s.\n", rename(f), p.name, f)
+		fmt.Fprintf(buf, "var %s = %s.%s\n\n", rename(f), p.name, f)
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestModelsUpToDate fails if running go generate would change models_gen.go.
+func TestModelsUpToDate(t *testing.T) {
+	want, err := os.ReadFile("../../../models_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		got, err := generate("../../..", "2025-09-01")
+		if err != nil {
+			t.Fatalf("generate() error = %v", err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatal("models_gen.go is out of date; run go generate in sample-app/azaisearch")
+		}
+	}
+}
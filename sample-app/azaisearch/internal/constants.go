@@ -1,5 +1,13 @@
 package internal
 
 const (
-	TokenScope = "https://search.azure.com/.default"
+	// Token audiences of Azure AI Search in the known Azure clouds.
+	AudiencePublic     = "https://search.azure.com"
+	AudienceChina      = "https://search.azure.cn"
+	AudienceGovernment = "https://search.azure.us"
+
+	// DNS suffixes of Azure AI Search service endpoints in the known Azure clouds.
+	EndpointSuffixPublic     = ".search.windows.net"
+	EndpointSuffixChina      = ".search.azure.cn"
+	EndpointSuffixGovernment = ".search.azure.us"
 )
@@ -0,0 +1,79 @@
+// Package schema contains helpers for working with index definitions.
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+const (
+	collectionPrefix = "Collection("
+	collectionSuffix = ")"
+)
+
+// Collection returns the collection type of elem, e.g. Collection(Edm.String).
+func Collection(elem searchservice.SearchFieldDataType) searchservice.SearchFieldDataType {
+	return searchservice.SearchFieldDataType(collectionPrefix + string(elem) + collectionSuffix)
+}
+
+// ElementType returns the element type of a collection type and true, or t and false if t is not a collection.
+func ElementType(t searchservice.SearchFieldDataType) (searchservice.SearchFieldDataType, bool) {
+	s := string(t)
+	if strings.HasPrefix(s, collectionPrefix) && strings.HasSuffix(s, collectionSuffix) {
+		return searchservice.SearchFieldDataType(s[len(collectionPrefix) : len(s)-len(collectionSuffix)]), true
+	}
+	return t, false
+}
+
+// IsComplex reports whether f is a complex field or a collection of complex values.
+func IsComplex(f *searchservice.SearchField) bool {
+	if f == nil || f.Type == nil {
+		return false
+	}
+	elem, _ := ElementType(*f.Type)
+	return elem == searchservice.SearchFieldDataTypeComplex
+}
+
+// FindField returns the field at path, with subfields of complex fields separated by '/'.
+func FindField(fields []*searchservice.SearchField, path string) (*searchservice.SearchField, error) {
+	var field *searchservice.SearchField
+	for i, name := range strings.Split(path, "/") {
+		if i > 0 {
+			if !IsComplex(field) {
+				return nil, fmt.Errorf("field %q: %q is not a complex field", path, strings.Join(strings.Split(path, "/")[:i], "/"))
+			}
+			fields = field.Fields
+		}
+		field = nil
+		for _, f := range fields {
+			if f != nil && f.Name != nil && *f.Name == name {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("field %q does not exist in the index", path)
+		}
+	}
+	return field, nil
+}
+
+// KeyField returns the key field of index.
+func KeyField(index *searchservice.SearchIndex) (*searchservice.SearchField, error) {
+	for _, f := range index.Fields {
+		if f != nil && f.Key != nil && *f.Key {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("index has no key field")
+}
+
+// Attribute returns the value of an optional boolean field attribute, or def if it is not set.
+func Attribute(v *bool, def bool) bool {
+	if v == nil {
+		return def
+	}
+	return *v
+}
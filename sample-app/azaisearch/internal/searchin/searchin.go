@@ -0,0 +1,50 @@
+// Package searchin builds the arguments of the search.in OData function. It is shared by the odata
+// package and the helpers of the generated clients, which can't import odata.
+package searchin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// delimiters are the candidate delimiters, in order of preference.
+const delimiters = ",|;~^#$!%&*+=/:@`"
+
+// Args returns the value list and delimiter arguments of search.in(field, values, delimiters) as
+// quoted OData strings, e.g. 'a,b', ','. The delimiter is always written, since without it the
+// service splits values on spaces as well as commas.
+//
+// delimiter lists the characters that separate values; if empty, the first candidate that occurs
+// in none of the values is chosen. It is an error if a value contains a delimiter character.
+func Args(values []string, delimiter string) (string, error) {
+	if delimiter == "" {
+		for _, d := range delimiters {
+			if !containsAny(values, string(d)) {
+				delimiter = string(d)
+				break
+			}
+		}
+		if delimiter == "" {
+			return "", errors.New("no delimiter found that does not occur in the values")
+		}
+	} else if containsAny(values, delimiter) {
+		return "", fmt.Errorf("delimiter %q occurs in the values", delimiter)
+	}
+	r, _ := utf8.DecodeRuneInString(delimiter)
+	return quote(strings.Join(values, string(r))) + ", " + quote(delimiter), nil
+}
+
+func containsAny(values []string, chars string) bool {
+	for _, v := range values {
+		if strings.ContainsAny(v, chars) {
+			return true
+		}
+	}
+	return false
+}
+
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,33 @@
+package searchin
+
+import "testing"
+
+func TestArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []string
+		delimiter string
+		want      string
+		wantErr   bool
+	}{
+		{"default delimiter", []string{"a", "b"}, "", "'a,b', ','", false},
+		{"values with spaces", []string{"New York", "Boston"}, "", "'New York,Boston', ','", false},
+		{"values with commas", []string{"a,b", "c"}, "", "'a,b|c', '|'", false},
+		{"explicit delimiter", []string{"a", "b"}, ";", "'a;b', ';'", false},
+		{"several delimiter characters", []string{"a", "b"}, ";|", "'a;b', ';|'", false},
+		{"quotes", []string{"it's"}, "", "'it''s', ','", false},
+		{"explicit delimiter in value", []string{"a;b"}, "|;", "", true},
+		{"no free delimiter", []string{delimiters}, "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Args(tt.values, tt.delimiter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Args() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Args() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,32 @@
 package searchindex
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 )
 
+// MaxIndexBatchActions is the maximum number of actions the service accepts in a single IndexBatch.
+const MaxIndexBatchActions = 1000
+
+// Per-document failures with a retriable status, see IsRetriableIndexingStatus, are retried up to
+// DefaultIndexMaxRetries times by default. The first retry waits DefaultIndexRetryDelay, and the
+// delay doubles with every further attempt.
+const (
+	DefaultIndexMaxRetries = 3
+	DefaultIndexRetryDelay = 800 * time.Millisecond
+)
+
+// IsRetriableIndexingStatus reports whether a per-document status code of an IndexingResult
+// indicates a transient failure: a version conflict (409), a merge on a document that is
+// concurrently being indexed (422) or an unavailable service (503).
+func IsRetriableIndexingStatus(status int32) bool {
+	return status == http.StatusConflict || status == http.StatusUnprocessableEntity || status == http.StatusServiceUnavailable
+}
+
 func NewDocumentsClient(endpoint string, indexName string, coreclient *azcore.Client) (*DocumentsClient, error) {
 	return &DocumentsClient{
 		internal:  coreclient,
@@ -11,3 +34,41 @@ func NewDocumentsClient(endpoint string, indexName string, coreclient *azcore.Cl
 		indexName: indexName,
 	}, nil
 }
+
+// IndexWithSplitting - Sends a batch of document write actions to the index like Index, but splits batches that
+// exceed the service limits. Batches with more than MaxIndexBatchActions actions are sent in chunks, and a batch
+// rejected with 413 Request Entity Too Large is bisected recursively until each part fits (e.g. for documents
+// with large vector fields). The results of all parts are merged in order.
+// If a part fails, IndexWithSplitting stops and returns the error together with the results of the parts that
+// were sent before, so callers can tell which actions were applied.
+//   - batch - The batch of index actions.
+//   - RequestOptions - RequestOptions contains a group of parameters for the DocumentsClient.Count method.
+//   - options - DocumentsClientIndexOptions contains the optional parameters for the DocumentsClient.Index method.
+func (client *DocumentsClient) IndexWithSplitting(ctx context.Context, batch IndexBatch, requestOptions *RequestOptions, options *DocumentsClientIndexOptions) (DocumentsClientIndexResponse, error) {
+	result := DocumentsClientIndexResponse{}
+	for start := 0; start < len(batch.Actions); start += MaxIndexBatchActions {
+		end := min(start+MaxIndexBatchActions, len(batch.Actions))
+		if err := client.indexSplitting(ctx, batch.Actions[start:end], requestOptions, options, &result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// indexSplitting sends actions, bisecting them on 413 responses, and appends the results to result.
+func (client *DocumentsClient) indexSplitting(ctx context.Context, actions []*IndexAction, requestOptions *RequestOptions, options *DocumentsClientIndexOptions, result *DocumentsClientIndexResponse) error {
+	resp, err := client.Index(ctx, IndexBatch{Actions: actions}, requestOptions, options)
+	if err == nil {
+		result.Results = append(result.Results, resp.Results...)
+		return nil
+	}
+	var respErr *azcore.ResponseError
+	if len(actions) < 2 || !errors.As(err, &respErr) || respErr.StatusCode != http.StatusRequestEntityTooLarge {
+		return err
+	}
+	mid := len(actions) / 2
+	if err := client.indexSplitting(ctx, actions[:mid], requestOptions, options, result); err != nil {
+		return err
+	}
+	return client.indexSplitting(ctx, actions[mid:], requestOptions, options, result)
+}
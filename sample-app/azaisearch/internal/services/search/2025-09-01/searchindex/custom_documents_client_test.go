@@ -0,0 +1,155 @@
+package searchindex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+func TestIsRetriableIndexingStatus(t *testing.T) {
+	tests := []struct {
+		status int32
+		want   bool
+	}{
+		{200, false},
+		{201, false},
+		{400, false},
+		{404, false},
+		{409, true},
+		{422, true},
+		{500, false},
+		{503, true},
+	}
+	for _, tt := range tests {
+		if got := IsRetriableIndexingStatus(tt.status); got != tt.want {
+			t.Errorf("IsRetriableIndexingStatus(%d) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+// transportFunc is a policy.Transporter that answers requests with a function.
+type transportFunc func(*http.Request) (*http.Response, error)
+
+func (f transportFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// jsonResponse returns a response to req with the given status code and JSON body.
+func jsonResponse(req *http.Request, status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// newTestDocumentsClient returns a DocumentsClient of the hotels index that sends its requests to transport.
+func newTestDocumentsClient(t *testing.T, transport policy.Transporter) *DocumentsClient {
+	t.Helper()
+	coreclient, err := azcore.NewClient("searchindex.test", "v1.0.0", runtime.PipelineOptions{}, &policy.ClientOptions{
+		Transport: transport,
+		Retry:     policy.RetryOptions{MaxRetries: -1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewDocumentsClient("https://test.search.windows.net", "hotels", coreclient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// indexTransport answers index requests. It rejects batches of more than maxActions actions with
+// 413 and batches containing failKey with 400, and records the size of every batch it receives.
+type indexTransport struct {
+	t          *testing.T
+	maxActions int
+	failKey    string
+	sizes      []int
+}
+
+func (f *indexTransport) Do(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost || !strings.HasSuffix(req.URL.Path, "/docs/search.index") {
+		f.t.Errorf("unexpected request %s %s", req.Method, req.URL)
+		return jsonResponse(req, http.StatusNotFound, `{}`), nil
+	}
+	var batch IndexBatch
+	if err := json.NewDecoder(req.Body).Decode(&batch); err != nil {
+		return nil, err
+	}
+	f.sizes = append(f.sizes, len(batch.Actions))
+	if len(batch.Actions) > f.maxActions {
+		return jsonResponse(req, http.StatusRequestEntityTooLarge, `{"error":{"code":"RequestEntityTooLarge","message":"too large"}}`), nil
+	}
+	var results []string
+	for _, a := range batch.Actions {
+		key := a.AdditionalProperties["id"].(string)
+		if key == f.failKey {
+			return jsonResponse(req, http.StatusBadRequest, `{"error":{"code":"InvalidDocument","message":"invalid"}}`), nil
+		}
+		results = append(results, fmt.Sprintf(`{"key":%q,"status":true,"statusCode":201}`, key))
+	}
+	return jsonResponse(req, http.StatusOK, `{"value":[`+strings.Join(results, ",")+`]}`), nil
+}
+
+func TestIndexWithSplitting(t *testing.T) {
+	tests := []struct {
+		name        string
+		actions     int
+		maxActions  int
+		failKey     string
+		wantSizes   []int
+		wantResults int
+		wantStatus  int
+	}{
+		{"single request", 3, 1000, "", []int{3}, 3, 0},
+		{"chunks of 1000", 2500, 1000, "", []int{1000, 1000, 500}, 2500, 0},
+		{"bisect to single actions", 4, 1, "", []int{4, 2, 1, 1, 2, 1, 1}, 4, 0},
+		{"bisect uneven", 3, 2, "", []int{3, 1, 2}, 3, 0},
+		{"single action too large", 3, 0, "", []int{3, 1}, 0, http.StatusRequestEntityTooLarge},
+		{"error in second chunk", 2500, 1000, "1500", []int{1000, 1000}, 1000, http.StatusBadRequest},
+		{"error after bisection", 4, 2, "0003", []int{4, 2, 2}, 2, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &indexTransport{t: t, maxActions: tt.maxActions, failKey: tt.failKey}
+			client := newTestDocumentsClient(t, transport)
+			upload := IndexActionTypeUpload
+			var batch IndexBatch
+			for i := range tt.actions {
+				batch.Actions = append(batch.Actions, &IndexAction{ActionType: &upload, AdditionalProperties: map[string]any{"id": fmt.Sprintf("%04d", i)}})
+			}
+
+			resp, err := client.IndexWithSplitting(t.Context(), batch, nil, nil)
+			var respErr *azcore.ResponseError
+			switch {
+			case tt.wantStatus == 0 && err != nil:
+				t.Fatalf("IndexWithSplitting() error = %v", err)
+			case tt.wantStatus != 0 && (!errors.As(err, &respErr) || respErr.StatusCode != tt.wantStatus):
+				t.Fatalf("IndexWithSplitting() error = %v, want status %d", err, tt.wantStatus)
+			}
+			if !slices.Equal(transport.sizes, tt.wantSizes) {
+				t.Errorf("batch sizes = %v, want %v", transport.sizes, tt.wantSizes)
+			}
+			if len(resp.Results) != tt.wantResults {
+				t.Fatalf("IndexWithSplitting() returned %d results, want %d", len(resp.Results), tt.wantResults)
+			}
+			for i, r := range resp.Results {
+				if want := fmt.Sprintf("%04d", i); *r.Key != want {
+					t.Fatalf("result %d has key %q, want %q", i, *r.Key, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package searchindex
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DeleteByFilterOptions contains the optional parameters for the DocumentsClient.DeleteByFilter method.
+type DeleteByFilterOptions struct {
+	// KeyField is the name of the index's key field. If empty, it is looked up from the index definition.
+	KeyField string
+
+	// DryRun only finds the matching documents and reports them in DeleteByFilterResult.Keys without deleting them.
+	DryRun bool
+
+	// BatchSize is the number of delete actions per IndexBatch. The default (and maximum) is MaxIndexBatchActions.
+	BatchSize int
+
+	// MaxRetries is the number of times a delete that failed with a transient per-document status
+	// (409, 422 or 503) is retried. The default is 3; a negative value disables retries.
+	MaxRetries int
+
+	// RetryDelay is the delay before the first retry, doubled for each further retry. The default is 800ms.
+	RetryDelay time.Duration
+}
+
+// DeleteByFilterResult contains the result of the DocumentsClient.DeleteByFilter method.
+type DeleteByFilterResult struct {
+	// Matched is the number of documents that matched the filter.
+	Matched int64
+
+	// Deleted is the number of documents that were deleted. It is zero in a dry run.
+	Deleted int64
+
+	// Failed contains the results of deletes that failed, after retries.
+	Failed []*IndexingResult
+
+	// Keys lists the keys of the matching documents in a dry run.
+	Keys []string
+}
+
+// DeleteByFilter - Deletes all documents matching filter.
+// Matching keys are streamed with ScanAll, so the key field must be filterable and sortable, and deleted in batches
+// as they are found. Per-document failures with a transient status are retried with exponential backoff; other
+// failures are reported in DeleteByFilterResult.Failed. If a request fails, the counts of the documents deleted so
+// far, including those of the parts of its batch that succeeded, are returned together with the error.
+//   - filter - An OData $filter expression selecting the documents to delete. It must not be empty.
+//   - options - DeleteByFilterOptions contains the optional parameters for the DocumentsClient.DeleteByFilter method.
+func (client *DocumentsClient) DeleteByFilter(ctx context.Context, filter string, options *DeleteByFilterOptions) (DeleteByFilterResult, error) {
+	if filter == "" {
+		return DeleteByFilterResult{}, errors.New("filter must not be empty")
+	}
+	opts := DeleteByFilterOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.BatchSize <= 0 || opts.BatchSize > MaxIndexBatchActions {
+		opts.BatchSize = MaxIndexBatchActions
+	}
+	if opts.MaxRetries == 0 {
+		opts.MaxRetries = DefaultIndexMaxRetries
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = DefaultIndexRetryDelay
+	}
+	if opts.KeyField == "" {
+		var err error
+		if opts.KeyField, err = client.GetKeyField(ctx); err != nil {
+			return DeleteByFilterResult{}, err
+		}
+	}
+
+	var result DeleteByFilterResult
+	batch := make([]string, 0, opts.BatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := client.deleteKeys(ctx, opts.KeyField, batch, &opts, &result)
+		batch = batch[:0]
+		return err
+	}
+	scan := client.ScanAll(ctx, &filter, []string{opts.KeyField}, &ScanOptions{KeyField: opts.KeyField})
+	for doc, err := range scan {
+		if err != nil {
+			return result, err
+		}
+		result.Matched++
+		if opts.DryRun {
+			result.Keys = append(result.Keys, doc.Key)
+			continue
+		}
+		batch = append(batch, doc.Key)
+		if len(batch) == opts.BatchSize {
+			if err := flush(); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, flush()
+}
+
+// deleteKeys deletes the documents with the given keys, retrying transient failures, and adds the outcome to result.
+func (client *DocumentsClient) deleteKeys(ctx context.Context, keyField string, keys []string, opts *DeleteByFilterOptions, result *DeleteByFilterResult) error {
+	for attempt := 0; len(keys) > 0; attempt++ {
+		if attempt > 0 {
+			t := time.NewTimer(opts.RetryDelay << (attempt - 1))
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				return ctx.Err()
+			}
+		}
+		actions := make([]*IndexAction, len(keys))
+		for i, k := range keys {
+			actions[i] = &IndexAction{
+				ActionType:           ptr(IndexActionTypeDelete),
+				AdditionalProperties: map[string]any{keyField: k},
+			}
+		}
+		// on error, resp holds the results of the parts that were sent, which are counted before returning
+		resp, err := client.IndexWithSplitting(ctx, IndexBatch{Actions: actions}, nil, nil)
+		var retry []string
+		for _, r := range resp.Results {
+			switch {
+			case r == nil:
+			case r.Succeeded != nil && *r.Succeeded:
+				result.Deleted++
+			case err == nil && r.Key != nil && r.StatusCode != nil && IsRetriableIndexingStatus(*r.StatusCode) && attempt < opts.MaxRetries:
+				retry = append(retry, *r.Key)
+			default:
+				result.Failed = append(result.Failed, r)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		keys = retry
+	}
+	return nil
+}
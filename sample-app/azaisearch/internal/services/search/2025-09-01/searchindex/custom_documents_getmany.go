@@ -0,0 +1,218 @@
+package searchindex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"sample-app/azaisearch/internal/searchin"
+)
+
+// GetManyOptions contains the optional parameters for the DocumentsClient.GetMany method.
+type GetManyOptions struct {
+	// KeyField is the name of the index's key field. If empty, it is looked up from the index definition,
+	// which also tells whether the key field is filterable.
+	KeyField string
+
+	// UseGet fetches each document with Get instead of using search.in filter queries. It is set
+	// automatically if KeyField is empty and the index's key field is not filterable.
+	UseGet bool
+
+	// ChunkSize is the number of keys per search.in query. The default (and maximum) is 1000.
+	ChunkSize int
+
+	// Concurrency is the maximum number of requests in flight. The default is 8.
+	Concurrency int
+
+	// UseNumber decodes the numbers in documents as json.Number instead of float64, so Edm.Int64
+	// values beyond 2^53 aren't rounded.
+	UseNumber bool
+}
+
+// GetManyResult contains the result of the DocumentsClient.GetMany method.
+type GetManyResult struct {
+	// Documents maps the keys that were found to their documents.
+	Documents map[string]map[string]any
+
+	// Missing lists the keys that don't exist in the index, in the order they were requested.
+	Missing []string
+}
+
+// GetMany - Retrieves the documents with the given keys.
+// Keys are looked up in chunks with search.in filters on the key field, which takes one request per
+// ChunkSize keys. If the key field is not filterable, each document is retrieved with Get instead.
+// Duplicate keys are looked up once. If the keys of a chunk contain every candidate search.in delimiter,
+// GetMany fails before sending any request; use UseGet for such keys.
+//   - keys - The keys of the documents to retrieve.
+//   - selectedFields - The fields to retrieve, pass nil to retrieve all retrievable fields. The key field is always included.
+//   - options - GetManyOptions contains the optional parameters for the DocumentsClient.GetMany method.
+func (client *DocumentsClient) GetMany(ctx context.Context, keys []string, selectedFields []string, options *GetManyOptions) (GetManyResult, error) {
+	if options == nil {
+		options = &GetManyOptions{}
+	}
+	keyField, useGet := options.KeyField, options.UseGet
+	if keyField == "" && !useGet {
+		var filterable bool
+		var err error
+		if keyField, filterable, err = client.getKeyField(ctx); err != nil {
+			return GetManyResult{}, err
+		}
+		useGet = !filterable
+	}
+	chunkSize := options.ChunkSize
+	if chunkSize <= 0 || chunkSize > 1000 {
+		chunkSize = 1000
+	}
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = 8
+	}
+
+	unique := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		if !seen[k] {
+			seen[k] = true
+			unique = append(unique, k)
+		}
+	}
+
+	result := GetManyResult{Documents: make(map[string]map[string]any, len(unique))}
+	var mu sync.Mutex
+	found := func(key string, doc map[string]any) {
+		mu.Lock()
+		defer mu.Unlock()
+		result.Documents[key] = doc
+	}
+
+	var tasks []func(context.Context) error
+	if useGet {
+		for _, k := range unique {
+			tasks = append(tasks, func(ctx context.Context) error {
+				doc, err := client.getDocument(ctx, k, selectedFields, options.UseNumber)
+				var respErr *azcore.ResponseError
+				if errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				found(k, doc)
+				return nil
+			})
+		}
+	} else {
+		var sel *string
+		if len(selectedFields) > 0 {
+			if !slices.Contains(selectedFields, keyField) {
+				selectedFields = append(slices.Clone(selectedFields), keyField)
+			}
+			sel = ptr(strings.Join(selectedFields, ","))
+		}
+		for chunk := range slices.Chunk(unique, chunkSize) {
+			filter, err := searchInFilter(keyField, chunk)
+			if err != nil {
+				return GetManyResult{}, err
+			}
+			tasks = append(tasks, func(ctx context.Context) error {
+				req := SearchRequest{
+					Filter: &filter,
+					Select: sel,
+					Top:    ptr(int32(len(chunk))),
+				}
+				docs, err := client.scanPage(ctx, req, options.UseNumber)
+				if err != nil {
+					return err
+				}
+				for _, doc := range docs {
+					if key, ok := doc[keyField].(string); ok {
+						found(key, doc)
+					}
+				}
+				return nil
+			})
+		}
+	}
+	if err := runConcurrently(ctx, concurrency, tasks); err != nil {
+		return GetManyResult{}, err
+	}
+
+	for _, k := range unique {
+		if _, ok := result.Documents[k]; !ok {
+			result.Missing = append(result.Missing, k)
+		}
+	}
+	return result, nil
+}
+
+// getDocument retrieves the document with the given key like Get. With useNumber, it decodes the
+// response body itself, as Get's unmarshalling turns all numbers into float64.
+func (client *DocumentsClient) getDocument(ctx context.Context, key string, selectedFields []string, useNumber bool) (map[string]any, error) {
+	options := &DocumentsClientGetOptions{SelectedFields: selectedFields}
+	if !useNumber {
+		resp, err := client.Get(ctx, key, options, nil)
+		return resp.Value, err
+	}
+	var httpResp *http.Response
+	if _, err := client.Get(policy.WithCaptureResponse(ctx, &httpResp), key, options, nil); err != nil {
+		return nil, err
+	}
+	body, err := runtime.Payload(httpResp)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unmarshalling document: %w", err)
+	}
+	return doc, nil
+}
+
+// searchInFilter returns a filter matching documents whose field is one of values.
+func searchInFilter(field string, values []string) (string, error) {
+	args, err := searchin.Args(values, "")
+	if err != nil {
+		return "", fmt.Errorf("search.in: %w", err)
+	}
+	return "search.in(" + field + ", " + args + ")", nil
+}
+
+// runConcurrently runs tasks with at most limit of them at a time. It cancels the remaining
+// tasks after the first error and returns that error.
+func runConcurrently(ctx context.Context, limit int, tasks []func(context.Context) error) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := task(ctx); err != nil {
+				cancel(err)
+			}
+		}()
+	}
+	wg.Wait()
+	return context.Cause(ctx)
+}
@@ -0,0 +1,28 @@
+package searchindex
+
+import "testing"
+
+func TestSearchInFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		want    string
+		wantErr bool
+	}{
+		{"plain keys", []string{"1", "2"}, "search.in(id, '1,2', ',')", false},
+		{"keys with commas", []string{"a,b", "c"}, "search.in(id, 'a,b|c', '|')", false},
+		{"keys with quotes", []string{"o'neil"}, "search.in(id, 'o''neil', ',')", false},
+		{"no free delimiter", []string{",|;~^#$!%&*+=/:@`"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := searchInFilter("id", tt.keys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("searchInFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("searchInFilter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
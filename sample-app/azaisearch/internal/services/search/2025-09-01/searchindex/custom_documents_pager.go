@@ -0,0 +1,89 @@
+package searchindex
+
+import (
+	"context"
+	"iter"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+// NewSearchPager - Searches for documents in the index and pages through all results.
+// The first page is requested with SearchPost. As long as the service returns NextPageParameters they are
+// resubmitted with SearchPost; otherwise a NextLink is followed with a GET request. Paging stops when the
+// service returns neither.
+//   - searchRequest - The definition of the Search request.
+//   - RequestOptions - RequestOptions contains a group of parameters for the DocumentsClient.Count method.
+//   - options - DocumentsClientSearchPostOptions contains the optional parameters for the DocumentsClient.SearchPost method.
+func (client *DocumentsClient) NewSearchPager(searchRequest SearchRequest, requestOptions *RequestOptions, options *DocumentsClientSearchPostOptions) *runtime.Pager[DocumentsClientSearchPostResponse] {
+	return runtime.NewPager(runtime.PagingHandler[DocumentsClientSearchPostResponse]{
+		More: func(page DocumentsClientSearchPostResponse) bool {
+			return page.NextPageParameters != nil || (page.NextLink != nil && len(*page.NextLink) > 0)
+		},
+		Fetcher: func(ctx context.Context, page *DocumentsClientSearchPostResponse) (DocumentsClientSearchPostResponse, error) {
+			switch {
+			case page == nil:
+				return client.SearchPost(ctx, searchRequest, requestOptions, options)
+			case page.NextPageParameters != nil:
+				return client.SearchPost(ctx, *page.NextPageParameters, requestOptions, options)
+			default:
+				return client.searchNextLink(ctx, *page.NextLink, requestOptions)
+			}
+		},
+		Tracer: client.internal.Tracer(),
+	})
+}
+
+// SearchResults - Searches for documents in the index and returns an iterator over the results of all pages,
+// see NewSearchPager. Iteration stops after the first error, which is yielded with a nil result.
+//   - searchRequest - The definition of the Search request.
+//   - RequestOptions - RequestOptions contains a group of parameters for the DocumentsClient.Count method.
+//   - options - DocumentsClientSearchPostOptions contains the optional parameters for the DocumentsClient.SearchPost method.
+func (client *DocumentsClient) SearchResults(ctx context.Context, searchRequest SearchRequest, requestOptions *RequestOptions, options *DocumentsClientSearchPostOptions) iter.Seq2[*SearchResult, error] {
+	return func(yield func(*SearchResult, error) bool) {
+		pager := client.NewSearchPager(searchRequest, requestOptions, options)
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, r := range page.Results {
+				if !yield(r, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// searchNextLink fetches the next page of search results from an @odata.nextLink URL.
+func (client *DocumentsClient) searchNextLink(ctx context.Context, nextLink string, requestOptions *RequestOptions) (DocumentsClientSearchPostResponse, error) {
+	req, err := client.searchNextLinkCreateRequest(ctx, nextLink, requestOptions)
+	if err != nil {
+		return DocumentsClientSearchPostResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return DocumentsClientSearchPostResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusPartialContent) {
+		err = runtime.NewResponseError(httpResp)
+		return DocumentsClientSearchPostResponse{}, err
+	}
+	return client.searchPostHandleResponse(httpResp)
+}
+
+// searchNextLinkCreateRequest creates the GET request for an @odata.nextLink URL.
+func (client *DocumentsClient) searchNextLinkCreateRequest(ctx context.Context, nextLink string, requestOptions *RequestOptions) (*policy.Request, error) {
+	req, err := runtime.NewRequest(ctx, http.MethodGet, nextLink)
+	if err != nil {
+		return nil, err
+	}
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if requestOptions != nil && requestOptions.XMSClientRequestID != nil {
+		req.Raw().Header["x-ms-client-request-id"] = []string{*requestOptions.XMSClientRequestID}
+	}
+	return req, nil
+}
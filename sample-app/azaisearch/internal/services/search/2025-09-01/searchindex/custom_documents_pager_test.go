@@ -0,0 +1,86 @@
+package searchindex
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestSearchResults(t *testing.T) {
+	const nextLink = "https://test.search.windows.net/indexes('hotels')/docs?api-version=2025-09-01&search=%2A&%24skip=4"
+	tests := []struct {
+		name        string
+		pages       []string
+		wantMethods []string
+		wantIDs     []string
+	}{
+		{
+			name:        "single page",
+			pages:       []string{`{"value":[{"id":"1"},{"id":"2"}]}`},
+			wantMethods: []string{"POST"},
+			wantIDs:     []string{"1", "2"},
+		},
+		{
+			name: "next page parameters then next link",
+			pages: []string{
+				// the service returns both for POST requests; the parameters are resubmitted with POST
+				`{"value":[{"id":"1"},{"id":"2"}],"@odata.nextLink":"` + nextLink + `","@search.nextPageParameters":{"search":"*","skip":2}}`,
+				`{"value":[{"id":"3"},{"id":"4"}],"@odata.nextLink":"` + nextLink + `"}`,
+				`{"value":[{"id":"5"}]}`,
+			},
+			wantMethods: []string{"POST", "POST", "GET"},
+			wantIDs:     []string{"1", "2", "3", "4", "5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []*http.Request
+			var bodies []SearchRequest
+			client := newTestDocumentsClient(t, transportFunc(func(req *http.Request) (*http.Response, error) {
+				var body SearchRequest
+				if req.Body != nil {
+					if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+						return nil, err
+					}
+				}
+				requests = append(requests, req)
+				bodies = append(bodies, body)
+				if len(requests) > len(tt.pages) {
+					t.Errorf("unexpected request %d: %s %s", len(requests), req.Method, req.URL)
+					return jsonResponse(req, http.StatusNotFound, `{}`), nil
+				}
+				return jsonResponse(req, http.StatusOK, tt.pages[len(requests)-1]), nil
+			}))
+
+			var ids []string
+			for r, err := range client.SearchResults(t.Context(), SearchRequest{SearchText: ptr("*")}, nil, nil) {
+				if err != nil {
+					t.Fatalf("SearchResults() error = %v", err)
+				}
+				ids = append(ids, r.AdditionalProperties["id"].(string))
+			}
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("SearchResults() ids = %v, want %v", ids, tt.wantIDs)
+			}
+			var methods []string
+			for _, req := range requests {
+				methods = append(methods, req.Method)
+			}
+			if !slices.Equal(methods, tt.wantMethods) {
+				t.Fatalf("SearchResults() sent %v, want %v", methods, tt.wantMethods)
+			}
+			for i, req := range requests {
+				switch {
+				case req.Method == http.MethodPost && req.URL.Path != "/indexes('hotels')/docs/search.post.search":
+					t.Errorf("request %d path = %q", i, req.URL.Path)
+				case req.Method == http.MethodGet && req.URL.String() != nextLink:
+					t.Errorf("request %d URL = %q, want %q", i, req.URL, nextLink)
+				}
+			}
+			if len(bodies) > 1 && (bodies[1].Skip == nil || *bodies[1].Skip != 2 || *bodies[1].SearchText != "*") {
+				t.Errorf("second request body = %+v, want the next page parameters", bodies[1])
+			}
+		})
+	}
+}
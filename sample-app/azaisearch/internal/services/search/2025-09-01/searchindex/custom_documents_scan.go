@@ -0,0 +1,222 @@
+package searchindex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+// ScanOptions contains the optional parameters for the DocumentsClient.ScanAll method.
+type ScanOptions struct {
+	// KeyField is the name of the index's key field. If empty, it is looked up from the index definition.
+	KeyField string
+
+	// StartAfterKey resumes a scan after the document with this key (exclusive), e.g. the Key of the last
+	// ScannedDocument a previous scan processed.
+	StartAfterKey *string
+
+	// PageSize is the number of documents requested per page. The default (and maximum) is 1000.
+	PageSize int32
+
+	// UseNumber decodes the numbers in documents as json.Number instead of float64, so Edm.Int64
+	// values beyond 2^53 aren't rounded.
+	UseNumber bool
+}
+
+// ScannedDocument is a document returned by DocumentsClient.ScanAll.
+type ScannedDocument struct {
+	// Key is the value of the document's key field; use it as checkpoint for ScanOptions.StartAfterKey.
+	Key string
+
+	// Document contains the selected fields of the document.
+	Document map[string]any
+}
+
+// ScanAll - Streams every document matching filter in ascending key order.
+// Unlike paging with $skip, which the service limits to 100,000, the scan orders by the key field and uses a
+// range filter on the last key seen (keyset pagination), so it works for indexes of any size. The key field
+// must be filterable and sortable. Iteration stops after the first error, which is yielded with a nil document.
+//   - filter - An OData $filter expression restricting the documents to scan, pass nil to scan all documents.
+//   - selectFields - The fields to retrieve, pass nil to retrieve all retrievable fields. The key field is always included.
+//   - options - ScanOptions contains the optional parameters for the DocumentsClient.ScanAll method.
+func (client *DocumentsClient) ScanAll(ctx context.Context, filter *string, selectFields []string, options *ScanOptions) iter.Seq2[*ScannedDocument, error] {
+	return func(yield func(*ScannedDocument, error) bool) {
+		if options == nil {
+			options = &ScanOptions{}
+		}
+		keyField := options.KeyField
+		if keyField == "" {
+			var err error
+			if keyField, err = client.GetKeyField(ctx); err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+		pageSize := options.PageSize
+		if pageSize <= 0 || pageSize > 1000 {
+			pageSize = 1000
+		}
+		var sel *string
+		if len(selectFields) > 0 {
+			if !slices.Contains(selectFields, keyField) {
+				selectFields = append(slices.Clone(selectFields), keyField)
+			}
+			sel = ptr(strings.Join(selectFields, ","))
+		}
+		orderBy := keyField + " asc"
+
+		lastKey := options.StartAfterKey
+		for {
+			req := SearchRequest{
+				Filter:  keysetFilter(filter, keyField, lastKey),
+				OrderBy: &orderBy,
+				Select:  sel,
+				Top:     &pageSize,
+			}
+			docs, err := client.scanPage(ctx, req, options.UseNumber)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, doc := range docs {
+				key, ok := doc[keyField].(string)
+				if !ok {
+					yield(nil, fmt.Errorf("search result has no string value for key field %q", keyField))
+					return
+				}
+				lastKey = &key
+				if !yield(&ScannedDocument{Key: key, Document: doc}, nil) {
+					return
+				}
+			}
+			if len(docs) < int(pageSize) {
+				return
+			}
+		}
+	}
+}
+
+// scanPage runs the search request of a scan page and returns the documents of its results,
+// without the @search annotations.
+func (client *DocumentsClient) scanPage(ctx context.Context, searchRequest SearchRequest, useNumber bool) ([]map[string]any, error) {
+	req, err := client.searchPostCreateRequest(ctx, searchRequest, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusPartialContent) {
+		return nil, runtime.NewResponseError(httpResp)
+	}
+	body, err := runtime.Payload(httpResp)
+	if err != nil {
+		return nil, err
+	}
+	return decodeScanPage(body, useNumber)
+}
+
+// decodeScanPage decodes the documents of a search response body.
+func decodeScanPage(body []byte, useNumber bool) ([]map[string]any, error) {
+	var page struct {
+		Value []map[string]any `json:"value"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	if useNumber {
+		dec.UseNumber()
+	}
+	if err := dec.Decode(&page); err != nil {
+		return nil, fmt.Errorf("unmarshalling search results: %w", err)
+	}
+	docs := page.Value[:0]
+	for _, doc := range page.Value {
+		if doc == nil {
+			continue
+		}
+		for k := range doc {
+			if strings.HasPrefix(k, "@search.") {
+				delete(doc, k)
+			}
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// GetKeyField - Retrieves the name of the index's key field from the index definition.
+func (client *DocumentsClient) GetKeyField(ctx context.Context) (string, error) {
+	key, _, err := client.getKeyField(ctx)
+	return key, err
+}
+
+// getKeyField retrieves the name of the index's key field and whether it is filterable.
+func (client *DocumentsClient) getKeyField(ctx context.Context) (string, bool, error) {
+	req, err := client.getIndexDefinitionCreateRequest(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return "", false, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		return "", false, runtime.NewResponseError(httpResp)
+	}
+	var index struct {
+		Fields []struct {
+			Name       string `json:"name"`
+			Key        bool   `json:"key"`
+			Filterable *bool  `json:"filterable"`
+		} `json:"fields"`
+	}
+	if err := runtime.UnmarshalAsJSON(httpResp, &index); err != nil {
+		return "", false, err
+	}
+	for _, f := range index.Fields {
+		if f.Key {
+			return f.Name, f.Filterable == nil || *f.Filterable, nil
+		}
+	}
+	return "", false, errors.New("index definition has no key field")
+}
+
+// getIndexDefinitionCreateRequest creates the request for the definition of the client's index.
+func (client *DocumentsClient) getIndexDefinitionCreateRequest(ctx context.Context) (*policy.Request, error) {
+	urlPath := "/indexes('{indexName}')"
+	urlPath = strings.ReplaceAll(urlPath, "{indexName}", client.indexName)
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.endpoint, urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2025-09-01")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// keysetFilter combines filter with a range condition on keyField that starts after lastKey.
+func keysetFilter(filter *string, keyField string, lastKey *string) *string {
+	if lastKey == nil {
+		return filter
+	}
+	cond := keyField + " gt '" + strings.ReplaceAll(*lastKey, "'", "''") + "'"
+	if filter == nil || *filter == "" {
+		return &cond
+	}
+	return ptr("(" + *filter + ") and " + cond)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,66 @@
+package searchindex
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecodeScanPage(t *testing.T) {
+	body := []byte(`{"@odata.count": 2, "value": [
+		{"@search.score": 1.0, "id": "1", "views": 9223372036854775807, "rating": 4.5},
+		{"@search.score": 1.0, "@search.highlights": {"name": ["<em>x</em>"]}, "id": "2", "views": 1}
+	]}`)
+	tests := []struct {
+		name      string
+		useNumber bool
+		want      string
+	}{
+		{"numbers", true, `[{"id":"1","rating":4.5,"views":9223372036854775807},{"id":"2","views":1}]`},
+		{"floats", false, `[{"id":"1","rating":4.5,"views":9223372036854776000},{"id":"2","views":1}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			docs, err := decodeScanPage(body, tt.useNumber)
+			if err != nil {
+				t.Fatalf("decodeScanPage() error = %v", err)
+			}
+			b, err := json.Marshal(docs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("decodeScanPage() = %s, want %s", b, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeysetFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  *string
+		lastKey *string
+		want    *string
+	}{
+		{"first page", nil, nil, nil},
+		{"first page with filter", ptr("rating ge 4"), nil, ptr("rating ge 4")},
+		{"next page", nil, ptr("a"), ptr("id gt 'a'")},
+		{"empty filter", ptr(""), ptr("a"), ptr("id gt 'a'")},
+		{"next page with filter", ptr("rating ge 4 or x"), ptr("o'neil"), ptr("(rating ge 4 or x) and id gt 'o''neil'")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keysetFilter(tt.filter, "id", tt.lastKey)
+			if (got == nil) != (tt.want == nil) || got != nil && *got != *tt.want {
+				t.Errorf("keysetFilter() = %v, want %v", deref(got), deref(tt.want))
+			}
+		})
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return "<nil>"
+	}
+	return *s
+}
@@ -0,0 +1,133 @@
+package searchindex
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+// MaxSearchGetURLLength is the default maximum length of a SearchGet request URL used by DocumentsClient.Search.
+// The service rejects URLs longer than 8 KB.
+const MaxSearchGetURLLength = 8 * 1024
+
+// DocumentsClientSearchOptions contains the optional parameters for the DocumentsClient.Search method.
+type DocumentsClientSearchOptions struct {
+	// The maximum length of the URL of a GET request; longer requests are sent with POST. Defaults to MaxSearchGetURLLength.
+	MaxURLLength int
+
+	// Always send the request with POST, e.g. to keep search text and filters out of proxy logs.
+	ForcePost bool
+}
+
+// DocumentsClientSearchResponse contains the response from method DocumentsClient.Search.
+type DocumentsClientSearchResponse struct {
+	// Response containing search results from an index.
+	SearchDocumentsResult
+
+	// The HTTP method the request was sent with, http.MethodGet or http.MethodPost.
+	Method string
+}
+
+// Search - Searches for documents in the index, sending the request with SearchGet where possible and with SearchPost otherwise.
+// GET requests can be cached by intermediaries and are preferred for small requests. POST is used when the request
+// has vector queries or a vector filter mode, which SearchGet doesn't support, or when its URL would exceed the maximum length.
+// If the operation fails it returns an *azcore.ResponseError type.
+//   - searchRequest - The definition of the Search request.
+//   - RequestOptions - RequestOptions contains a group of parameters for the DocumentsClient.Count method.
+//   - options - DocumentsClientSearchOptions contains the optional parameters for the DocumentsClient.Search method.
+func (client *DocumentsClient) Search(ctx context.Context, searchRequest SearchRequest, requestOptions *RequestOptions, options *DocumentsClientSearchOptions) (DocumentsClientSearchResponse, error) {
+	if options == nil {
+		options = &DocumentsClientSearchOptions{}
+	}
+	maxURLLength := options.MaxURLLength
+	if maxURLLength <= 0 {
+		maxURLLength = MaxSearchGetURLLength
+	}
+
+	if !options.ForcePost && !requiresSearchPost(searchRequest) {
+		getOptions, searchOptions := searchGetParameters(searchRequest)
+		req, err := client.searchGetCreateRequest(ctx, getOptions, searchOptions, requestOptions)
+		if err != nil {
+			return DocumentsClientSearchResponse{}, err
+		}
+		if len(req.Raw().URL.String()) <= maxURLLength {
+			// send the request built for the length check, as SearchGet would
+			httpResp, err := client.internal.Pipeline().Do(req)
+			if err != nil {
+				return DocumentsClientSearchResponse{}, err
+			}
+			if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusPartialContent) {
+				return DocumentsClientSearchResponse{}, runtime.NewResponseError(httpResp)
+			}
+			resp, err := client.searchGetHandleResponse(httpResp)
+			if err != nil {
+				return DocumentsClientSearchResponse{}, err
+			}
+			return DocumentsClientSearchResponse{SearchDocumentsResult: resp.SearchDocumentsResult, Method: http.MethodGet}, nil
+		}
+	}
+
+	resp, err := client.SearchPost(ctx, searchRequest, requestOptions, nil)
+	if err != nil {
+		return DocumentsClientSearchResponse{}, err
+	}
+	return DocumentsClientSearchResponse{SearchDocumentsResult: resp.SearchDocumentsResult, Method: http.MethodPost}, nil
+}
+
+// requiresSearchPost reports whether searchRequest uses features that SearchGet doesn't support.
+func requiresSearchPost(searchRequest SearchRequest) bool {
+	return len(searchRequest.VectorQueries) > 0 || searchRequest.VectorFilterMode != nil
+}
+
+// searchGetParameters converts searchRequest into the parameters of SearchGet.
+func searchGetParameters(searchRequest SearchRequest) (*DocumentsClientSearchGetOptions, *SearchOptions) {
+	return &DocumentsClientSearchGetOptions{SearchText: searchRequest.SearchText}, &SearchOptions{
+		Answers:                       searchRequest.Answers,
+		Captions:                      searchRequest.Captions,
+		Debug:                         searchRequest.Debug,
+		Facets:                        derefAll(searchRequest.Facets),
+		Filter:                        searchRequest.Filter,
+		HighlightFields:               list(searchRequest.HighlightFields),
+		HighlightPostTag:              searchRequest.HighlightPostTag,
+		HighlightPreTag:               searchRequest.HighlightPreTag,
+		IncludeTotalResultCount:       searchRequest.IncludeTotalResultCount,
+		MinimumCoverage:               searchRequest.MinimumCoverage,
+		OrderBy:                       list(searchRequest.OrderBy),
+		QueryType:                     searchRequest.QueryType,
+		ScoringParameters:             derefAll(searchRequest.ScoringParameters),
+		ScoringProfile:                searchRequest.ScoringProfile,
+		ScoringStatistics:             searchRequest.ScoringStatistics,
+		SearchFields:                  list(searchRequest.SearchFields),
+		SearchMode:                    searchRequest.SearchMode,
+		Select:                        list(searchRequest.Select),
+		SemanticConfiguration:         searchRequest.SemanticConfiguration,
+		SemanticErrorHandling:         searchRequest.SemanticErrorHandling,
+		SemanticMaxWaitInMilliseconds: searchRequest.SemanticMaxWaitInMilliseconds,
+		SemanticQuery:                 searchRequest.SemanticQuery,
+		SessionID:                     searchRequest.SessionID,
+		Skip:                          searchRequest.Skip,
+		Top:                           searchRequest.Top,
+	}
+}
+
+// list returns the comma-separated list s as a single-element slice, which SearchGet joins back with commas.
+func list(s *string) []string {
+	if s == nil {
+		return nil
+	}
+	return []string{*s}
+}
+
+func derefAll(s []*string) []string {
+	if s == nil {
+		return nil
+	}
+	values := make([]string, 0, len(s))
+	for _, v := range s {
+		if v != nil {
+			values = append(values, *v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,126 @@
+package searchindex
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	vectorKind := VectorQueryKindText
+	tests := []struct {
+		name       string
+		request    SearchRequest
+		options    *DocumentsClientSearchOptions
+		wantMethod string
+		wantQuery  url.Values
+	}{
+		{
+			name: "options as query parameters",
+			request: SearchRequest{
+				SearchText:              ptr("beach hotel"),
+				Filter:                  ptr("rating ge 4"),
+				OrderBy:                 ptr("rating desc,name"),
+				Select:                  ptr("id,name"),
+				Top:                     ptr(int32(10)),
+				Skip:                    ptr(int32(20)),
+				IncludeTotalResultCount: ptr(true),
+				Facets:                  []*string{ptr("category"), ptr("rating,interval:1")},
+				HighlightFields:         ptr("description"),
+				SearchFields:            ptr("name,description"),
+				SearchMode:              ptr(SearchModeAll),
+				QueryType:               ptr(QueryTypeFull),
+				ScoringParameters:       []*string{ptr("loc--122,47")},
+			},
+			wantMethod: http.MethodGet,
+			wantQuery: url.Values{
+				"api-version":      {"2025-09-01"},
+				"search":           {"beach hotel"},
+				"$filter":          {"rating ge 4"},
+				"$orderby":         {"rating desc,name"},
+				"$select":          {"id,name"},
+				"$top":             {"10"},
+				"$skip":            {"20"},
+				"$count":           {"true"},
+				"facet":            {"category", "rating,interval:1"},
+				"highlight":        {"description"},
+				"searchFields":     {"name,description"},
+				"searchMode":       {"all"},
+				"queryType":        {"full"},
+				"scoringParameter": {"loc--122,47"},
+			},
+		},
+		{
+			name:       "vector query",
+			request:    SearchRequest{VectorQueries: []VectorQueryClassification{&VectorizableTextQuery{Kind: &vectorKind, Text: ptr("beach"), Fields: ptr("v")}}},
+			wantMethod: http.MethodPost,
+		},
+		{
+			name:       "vector filter mode",
+			request:    SearchRequest{SearchText: ptr("beach"), VectorFilterMode: ptr(VectorFilterModePreFilter)},
+			wantMethod: http.MethodPost,
+		},
+		{
+			name:       "forced post",
+			request:    SearchRequest{SearchText: ptr("beach")},
+			options:    &DocumentsClientSearchOptions{ForcePost: true},
+			wantMethod: http.MethodPost,
+		},
+		{
+			name:       "url too long",
+			request:    SearchRequest{SearchText: ptr("beach"), Filter: ptr("search.in(id, '" + strings.Repeat("x", MaxSearchGetURLLength) + "')")},
+			wantMethod: http.MethodPost,
+		},
+		{
+			name:       "url longer than MaxURLLength",
+			request:    SearchRequest{SearchText: ptr("beach"), Filter: ptr("rating ge 4")},
+			options:    &DocumentsClientSearchOptions{MaxURLLength: 50},
+			wantMethod: http.MethodPost,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []*http.Request
+			client := newTestDocumentsClient(t, transportFunc(func(req *http.Request) (*http.Response, error) {
+				requests = append(requests, req)
+				return jsonResponse(req, http.StatusOK, `{"value":[{"@search.score":1,"id":"1"}]}`), nil
+			}))
+
+			resp, err := client.Search(t.Context(), tt.request, nil, tt.options)
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if len(requests) != 1 {
+				t.Fatalf("Search() sent %d requests, want 1", len(requests))
+			}
+			req := requests[0]
+			if req.Method != tt.wantMethod || resp.Method != tt.wantMethod {
+				t.Errorf("Search() sent %s, reported %s, want %s", req.Method, resp.Method, tt.wantMethod)
+			}
+			wantPath := "/indexes('hotels')/docs"
+			if tt.wantMethod == http.MethodPost {
+				wantPath += "/search.post.search"
+			}
+			if req.URL.Path != wantPath {
+				t.Errorf("Search() path = %q, want %q", req.URL.Path, wantPath)
+			}
+			if tt.wantQuery != nil {
+				got := req.URL.Query()
+				for k, want := range tt.wantQuery {
+					if strings.Join(got[k], "|") != strings.Join(want, "|") {
+						t.Errorf("Search() query parameter %s = %q, want %q", k, got[k], want)
+					}
+				}
+				for k := range got {
+					if _, ok := tt.wantQuery[k]; !ok {
+						t.Errorf("Search() has unexpected query parameter %s = %q", k, got[k])
+					}
+				}
+			}
+			if len(resp.Results) != 1 || resp.Results[0].AdditionalProperties["id"] != "1" {
+				t.Errorf("Search() results = %v", resp.Results)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package searchservice
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+func NewDataSourcesClient(endpoint string, coreclient *azcore.Client) (*DataSourcesClient, error) {
+	return &DataSourcesClient{
+		internal: coreclient,
+		endpoint: endpoint,
+	}, nil
+}
@@ -0,0 +1,12 @@
+package searchservice
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+func NewIndexersClient(endpoint string, coreclient *azcore.Client) (*IndexersClient, error) {
+	return &IndexersClient{
+		internal: coreclient,
+		endpoint: endpoint,
+	}, nil
+}
@@ -0,0 +1,12 @@
+package searchservice
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+func NewSearchClient(endpoint string, coreclient *azcore.Client) (*SearchClient, error) {
+	return &SearchClient{
+		internal: coreclient,
+		endpoint: endpoint,
+	}, nil
+}
@@ -0,0 +1,12 @@
+package searchservice
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+func NewSkillsetsClient(endpoint string, coreclient *azcore.Client) (*SkillsetsClient, error) {
+	return &SkillsetsClient{
+		internal: coreclient,
+		endpoint: endpoint,
+	}, nil
+}
@@ -0,0 +1,12 @@
+package searchservice
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+func NewSynonymMapsClient(endpoint string, coreclient *azcore.Client) (*SynonymMapsClient, error) {
+	return &SynonymMapsClient{
+		internal: coreclient,
+		endpoint: endpoint,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package searchservice
+
+import "time"
+
+// ParseDateTimeOffset parses an Edm.DateTimeOffset value as returned by the service. Like the models of this
+// package, it accepts RFC 3339 timestamps as well as UTC timestamps without a time zone suffix or 'T' separator.
+func ParseDateTimeOffset(s string) (time.Time, error) {
+	var t dateTimeRFC3339
+	if err := t.UnmarshalText([]byte(s)); err != nil {
+		return time.Time{}, err
+	}
+	return time.Time(t), nil
+}
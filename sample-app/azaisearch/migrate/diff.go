@@ -0,0 +1,338 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// fieldAttributeImpacts lists the field attributes that can be changed on an existing field.
+// Changing any other attribute requires a rebuild.
+var fieldAttributeImpacts = map[string]Impact{
+	"retrievable":    InPlace,
+	"searchAnalyzer": InPlace,
+	"synonymMaps":    InPlace,
+}
+
+// collectionRule is how additions, modifications and removals of the named items of a
+// collection, such as custom analyzers, can be applied.
+type collectionRule struct {
+	add, modify, remove Impact
+	what                string
+}
+
+// collectionRules lists the named collections of an index definition by JSON path.
+var collectionRules = map[string]collectionRule{
+	"scoringProfiles":           {InPlace, InPlace, InPlace, "scoring profile"},
+	"suggesters":                {InPlace, Rebuild, Rebuild, "suggester"},
+	"analyzers":                 {Downtime, Rebuild, Rebuild, "custom analyzer"},
+	"tokenizers":                {Downtime, Rebuild, Rebuild, "custom tokenizer"},
+	"tokenFilters":              {Downtime, Rebuild, Rebuild, "custom token filter"},
+	"charFilters":               {Downtime, Rebuild, Rebuild, "custom char filter"},
+	"normalizers":               {Downtime, Rebuild, Rebuild, "custom normalizer"},
+	"semantic/configurations":   {InPlace, InPlace, InPlace, "semantic configuration"},
+	"vectorSearch/profiles":     {InPlace, InPlace, InPlace, "vector profile"},
+	"vectorSearch/vectorizers":  {InPlace, InPlace, InPlace, "vectorizer"},
+	"vectorSearch/algorithms":   {InPlace, Rebuild, Rebuild, "vector algorithm"},
+	"vectorSearch/compressions": {InPlace, Rebuild, Rebuild, "vector compression"},
+}
+
+// settingImpacts lists the other settings of an index definition by JSON path. Settings that
+// aren't listed, such as ones added in newer API versions, are assumed to require a rebuild.
+var settingImpacts = map[string]Impact{
+	"description":                   InPlace,
+	"defaultScoringProfile":         InPlace,
+	"corsOptions":                   InPlace,
+	"encryptionKey":                 InPlace,
+	"semantic/defaultConfiguration": InPlace,
+	"similarity":                    Rebuild,
+}
+
+// keptSettings are the settings that the service fills in or keeps when they are unset: new
+// indexes get the BM25 similarity, and null encryption keys are ignored. Leaving them out of
+// desired doesn't change them.
+var keptSettings = map[string]bool{"similarity": true, "encryptionKey": true}
+
+// nestedSettings are the settings whose properties are compared individually.
+var nestedSettings = map[string]bool{"semantic": true, "vectorSearch": true}
+
+// Diff compares the live definition of an index with the desired one and returns the plan to
+// migrate it. A nil current index plans the creation of desired.
+//
+// Field attributes and properties of named items, such as custom analyzers, that are unset in
+// desired are treated as unchanged rather than removed: the service fills in defaults, such as
+// algorithm parameters, that desired usually omits. Unset field attributes are taken from the live
+// index when the plan is applied. Fields, named items and top-level settings, such as CORS
+// options, that are missing from desired are removed, except for the similarity algorithm and
+// the encryption key, which are kept.
+func Diff(current *searchservice.SearchIndex, desired *searchservice.SearchIndex) (*Plan, error) {
+	if desired == nil || desired.Name == nil {
+		return nil, fmt.Errorf("desired index has no name")
+	}
+	p := &Plan{IndexName: *desired.Name, desired: desired, current: current}
+	if current == nil {
+		p.add("", Added, InPlace, "create index with %d fields", len(desired.Fields))
+		return p, nil
+	}
+	cur, err := toMap(current)
+	if err != nil {
+		return nil, err
+	}
+	des, err := toMap(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	newFields := map[string]bool{}
+	p.diffFields("fields", list(cur["fields"]), list(des["fields"]), newFields)
+	for _, path := range sortedKeys(collectionRules) {
+		p.diffCollection(path, list(lookup(cur, path)), list(lookup(des, path)), newFields)
+	}
+	p.diffSettings("", cur, des)
+	return p, nil
+}
+
+// diffFields adds the changes between the current and desired fields at path, recursively for
+// subfields. The paths of added fields are recorded in added.
+func (p *Plan) diffFields(path string, current, desired []any, added map[string]bool) {
+	byName := index(current)
+	seen := map[string]bool{}
+	for _, d := range desired {
+		df, _ := d.(map[string]any)
+		name, _ := df["name"].(string)
+		fieldPath := path + "/" + name
+		seen[name] = true
+		cf, ok := byName[name]
+		if !ok {
+			p.addField(fieldPath, df, added)
+			continue
+		}
+		for _, attr := range sortedKeys(df) {
+			if attr == "name" || attr == "fields" || df[attr] == nil || covers(cf[attr], df[attr]) {
+				continue
+			}
+			impact, ok := fieldAttributeImpacts[attr]
+			if !ok {
+				impact = Rebuild
+			}
+			p.add(fieldPath, Modified, impact, "%s: %s -> %s", attr, format(cf[attr]), format(df[attr]))
+		}
+		if df["fields"] != nil {
+			p.diffFields(fieldPath, list(cf["fields"]), list(df["fields"]), added)
+		}
+	}
+	for _, c := range current {
+		cf, _ := c.(map[string]any)
+		if name, _ := cf["name"].(string); !seen[name] {
+			p.add(path+"/"+name, Removed, Rebuild, "remove field of type %s; fields cannot be deleted", format(cf["type"]))
+		}
+	}
+}
+
+func (p *Plan) addField(path string, f map[string]any, added map[string]bool) {
+	added[strings.TrimPrefix(path, "fields/")] = true
+	if key, _ := f["key"].(bool); key {
+		p.add(path, Added, Rebuild, "add key field; the key of an index cannot change")
+		return
+	}
+	p.add(path, Added, InPlace, "add field of type %v", f["type"])
+	for _, sub := range list(f["fields"]) {
+		if sf, ok := sub.(map[string]any); ok {
+			name, _ := sf["name"].(string)
+			added[strings.TrimPrefix(path, "fields/")+"/"+name] = true
+		}
+	}
+}
+
+// diffCollection adds the changes between the current and desired named items at path.
+func (p *Plan) diffCollection(path string, current, desired []any, newFields map[string]bool) {
+	rule := collectionRules[path]
+	byName := index(current)
+	seen := map[string]bool{}
+	for _, d := range desired {
+		item, _ := d.(map[string]any)
+		name, _ := item["name"].(string)
+		seen[name] = true
+		c, ok := byName[name]
+		switch {
+		case !ok && path == "suggesters" && !onlyNewFields(item, newFields):
+			p.add(path+"/"+name, Added, Rebuild, "add %s on existing fields; suggesters can only use new fields", rule.what)
+		case !ok:
+			p.add(path+"/"+name, Added, rule.add, "add %s", rule.what)
+		case !covers(c, item):
+			p.add(path+"/"+name, Modified, rule.modify, "change %s: %s", rule.what, strings.Join(changedKeys(c, item), ", "))
+		}
+	}
+	for _, c := range current {
+		item, _ := c.(map[string]any)
+		if name, _ := item["name"].(string); !seen[name] {
+			p.add(path+"/"+name, Removed, rule.remove, "remove %s", rule.what)
+		}
+	}
+}
+
+// diffSettings adds the changes of the settings of the object at path that aren't fields or
+// named collections. Settings that are unset in desired are removed, unless they are kept.
+func (p *Plan) diffSettings(path string, current, desired map[string]any) {
+	keys := map[string]bool{}
+	for k := range current {
+		keys[k] = true
+	}
+	for k := range desired {
+		keys[k] = true
+	}
+	for _, key := range sortedKeys(keys) {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "/" + key
+		}
+		if _, ok := collectionRules[keyPath]; ok || keyPath == "fields" || keyPath == "name" || keyPath == "@odata.etag" {
+			continue
+		}
+		c, d := current[key], desired[key]
+		if nestedSettings[keyPath] {
+			cm, _ := c.(map[string]any)
+			dm, _ := d.(map[string]any)
+			p.diffSettings(keyPath, cm, dm)
+			continue
+		}
+		impact, ok := settingImpacts[keyPath]
+		if !ok {
+			impact = Rebuild
+		}
+		switch {
+		case d == nil && (c == nil || keptSettings[keyPath]):
+		case d == nil:
+			p.add(keyPath, Removed, impact, "remove %s", format(c))
+		case c == nil:
+			p.add(keyPath, Added, impact, "set to %s", format(d))
+		case !covers(c, d):
+			p.add(keyPath, Modified, impact, "%s -> %s", format(c), format(d))
+		}
+	}
+}
+
+// onlyNewFields reports whether all source fields of a suggester are in newFields.
+func onlyNewFields(suggester map[string]any, newFields map[string]bool) bool {
+	for _, f := range list(suggester["sourceFields"]) {
+		if name, _ := f.(string); !newFields[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// covers reports whether current matches desired in every property that desired sets. Properties
+// that desired leaves out or sets to null are ignored, so defaults filled in by the service match.
+func covers(current, desired any) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		c, ok := current.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			if !covers(c[k], v) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		c, ok := current.([]any)
+		if !ok || len(c) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !covers(c[i], d[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(current, desired)
+}
+
+// changedKeys returns the properties of desired that current doesn't match.
+func changedKeys(current, desired map[string]any) []string {
+	var keys []string
+	for _, k := range sortedKeys(desired) {
+		if !covers(current[k], desired[k]) {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// toMap returns the JSON object form of v.
+func toMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// lookup returns the value at the slash-separated path of m.
+func lookup(m map[string]any, path string) any {
+	var v any = m
+	for _, key := range strings.Split(path, "/") {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = obj[key]
+	}
+	return v
+}
+
+func list(v any) []any {
+	l, _ := v.([]any)
+	return l
+}
+
+// index returns the objects of items by name.
+func index(items []any) map[string]map[string]any {
+	m := make(map[string]map[string]any, len(items))
+	for _, item := range items {
+		if obj, ok := item.(map[string]any); ok {
+			name, _ := obj["name"].(string)
+			m[name] = obj
+		}
+	}
+	return m
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// format returns the JSON form of v for a plan, shortened if it is long.
+func format(v any) string {
+	if v == nil {
+		return "(unset)"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	const maxLen = 80
+	if s := string(b); len(s) > maxLen {
+		return s[:maxLen-3] + "..."
+	}
+	return string(b)
+}
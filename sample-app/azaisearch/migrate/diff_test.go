@@ -0,0 +1,274 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// hotels is the live definition of the test index, as the service returns it.
+const hotels = `{
+	"name": "hotels",
+	"@odata.etag": "\"0x1\"",
+	"fields": [
+		{"name": "id", "type": "Edm.String", "key": true, "searchable": false, "filterable": true, "retrievable": true, "stored": true, "sortable": false, "facetable": false, "synonymMaps": []},
+		{"name": "name", "type": "Edm.String", "searchable": true, "filterable": false, "retrievable": true, "stored": true, "sortable": true, "facetable": false, "analyzer": "en.microsoft", "synonymMaps": []},
+		{"name": "address", "type": "Edm.ComplexType", "fields": [
+			{"name": "city", "type": "Edm.String", "searchable": true, "filterable": true, "retrievable": true, "stored": true, "sortable": false, "facetable": true, "synonymMaps": []}
+		]}
+	],
+	"similarity": {"@odata.type": "#Microsoft.Azure.Search.BM25Similarity"},
+	"suggesters": [],
+	"analyzers": [
+		{"@odata.type": "#Microsoft.Azure.Search.CustomAnalyzer", "name": "folding", "tokenizer": "standard_v2", "tokenFilters": ["lowercase", "asciifolding"], "charFilters": []}
+	],
+	"corsOptions": {"allowedOrigins": ["*"], "maxAgeInSeconds": 300}
+}`
+
+// desiredHotels is the desired definition matching hotels, without the defaults the service fills in.
+const desiredHotels = `{
+	"name": "hotels",
+	"fields": [
+		{"name": "id", "type": "Edm.String", "key": true, "filterable": true},
+		{"name": "name", "type": "Edm.String", "searchable": true, "sortable": true, "analyzer": "en.microsoft"},
+		{"name": "address", "type": "Edm.ComplexType", "fields": [
+			{"name": "city", "type": "Edm.String", "filterable": true, "facetable": true}
+		]}
+	],
+	"analyzers": [
+		{"@odata.type": "#Microsoft.Azure.Search.CustomAnalyzer", "name": "folding", "tokenizer": "standard_v2", "tokenFilters": ["lowercase", "asciifolding"]}
+	],
+	"corsOptions": {"allowedOrigins": ["*"]}
+}`
+
+// edit returns the JSON definition s with the function applied to its object form.
+func edit(t *testing.T, s string, f func(m map[string]any)) string {
+	t.Helper()
+	var m map[string]any
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	f(m)
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func parseIndex(t *testing.T, s string) *searchservice.SearchIndex {
+	t.Helper()
+	var index searchservice.SearchIndex
+	if err := json.Unmarshal([]byte(s), &index); err != nil {
+		t.Fatal(err)
+	}
+	return &index
+}
+
+// field returns the field at the slash-separated path of the definition m.
+func field(m map[string]any, path ...string) map[string]any {
+	var f map[string]any
+	fields := list(m["fields"])
+	for _, name := range path {
+		f = index(fields)[name]
+		fields = list(f["fields"])
+	}
+	return f
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		desired func(m map[string]any)
+		want    []string
+	}{
+		{
+			name:    "unchanged",
+			current: desiredHotels,
+			desired: func(m map[string]any) {},
+		},
+		{
+			name:    "live definition with service defaults",
+			current: hotels,
+			desired: func(m map[string]any) {},
+		},
+		{
+			name:    "similarity changed",
+			current: hotels,
+			desired: func(m map[string]any) {
+				m["similarity"] = map[string]any{"@odata.type": "#Microsoft.Azure.Search.ClassicSimilarity"}
+			},
+			want: []string{"~ similarity rebuild"},
+		},
+		{
+			name:    "field attributes",
+			current: hotels,
+			desired: func(m map[string]any) {
+				f := field(m, "name")
+				f["filterable"] = true
+				f["retrievable"] = false
+				f["synonymMaps"] = []any{"hotel-synonyms"}
+				f["searchAnalyzer"] = "standard.lucene"
+				field(m, "id")["type"] = "Edm.Int64"
+			},
+			want: []string{
+				"~ fields/id rebuild",
+				"~ fields/name rebuild",
+				"~ fields/name in-place",
+				"~ fields/name in-place",
+				"~ fields/name in-place",
+			},
+		},
+		{
+			name:    "fields added and removed",
+			current: hotels,
+			desired: func(m map[string]any) {
+				m["fields"] = []any{
+					field(m, "id"),
+					field(m, "address"),
+					map[string]any{"name": "rating", "type": "Edm.Double", "filterable": true},
+				}
+			},
+			want: []string{"+ fields/rating in-place", "- fields/name rebuild"},
+		},
+		{
+			name:    "key field added",
+			current: hotels,
+			desired: func(m map[string]any) {
+				field(m, "id")["key"] = false
+				m["fields"] = append(list(m["fields"]), map[string]any{"name": "hotelId", "type": "Edm.String", "key": true})
+			},
+			want: []string{"~ fields/id rebuild", "+ fields/hotelId rebuild"},
+		},
+		{
+			name:    "nested subfields",
+			current: hotels,
+			desired: func(m map[string]any) {
+				address := field(m, "address")
+				field(m, "address", "city")["sortable"] = true
+				address["fields"] = append(list(address["fields"]), map[string]any{"name": "zip", "type": "Edm.String"})
+			},
+			want: []string{"~ fields/address/city rebuild", "+ fields/address/zip in-place"},
+		},
+		{
+			name:    "suggester on existing fields",
+			current: hotels,
+			desired: func(m map[string]any) {
+				m["suggesters"] = []any{map[string]any{"name": "sg", "searchMode": "analyzingInfixMatching", "sourceFields": []any{"name"}}}
+			},
+			want: []string{"+ suggesters/sg rebuild"},
+		},
+		{
+			name:    "suggester on new fields",
+			current: hotels,
+			desired: func(m map[string]any) {
+				m["fields"] = append(list(m["fields"]), map[string]any{"name": "title", "type": "Edm.String", "searchable": true})
+				address := field(m, "address")
+				address["fields"] = append(list(address["fields"]), map[string]any{"name": "street", "type": "Edm.String", "searchable": true})
+				m["suggesters"] = []any{map[string]any{"name": "sg", "searchMode": "analyzingInfixMatching", "sourceFields": []any{"title", "address/street"}}}
+			},
+			want: []string{"+ fields/address/street in-place", "+ fields/title in-place", "+ suggesters/sg in-place"},
+		},
+		{
+			name:    "suggester on new complex field",
+			current: hotels,
+			desired: func(m map[string]any) {
+				m["fields"] = append(list(m["fields"]), map[string]any{"name": "location", "type": "Edm.ComplexType", "fields": []any{
+					map[string]any{"name": "area", "type": "Edm.String", "searchable": true},
+				}})
+				m["suggesters"] = []any{map[string]any{"name": "sg", "searchMode": "analyzingInfixMatching", "sourceFields": []any{"location/area"}}}
+			},
+			want: []string{"+ fields/location in-place", "+ suggesters/sg in-place"},
+		},
+		{
+			name:    "analyzer added",
+			current: hotels,
+			desired: func(m map[string]any) {
+				m["analyzers"] = append(list(m["analyzers"]), map[string]any{
+					"@odata.type": "#Microsoft.Azure.Search.CustomAnalyzer", "name": "plain", "tokenizer": "whitespace",
+				})
+			},
+			want: []string{"+ analyzers/plain downtime"},
+		},
+		{
+			name:    "analyzer modified",
+			current: hotels,
+			desired: func(m map[string]any) {
+				index(list(m["analyzers"]))["folding"]["tokenFilters"] = []any{"lowercase"}
+			},
+			want: []string{"~ analyzers/folding rebuild"},
+		},
+		{
+			name:    "analyzer removed",
+			current: hotels,
+			desired: func(m map[string]any) {
+				delete(m, "analyzers")
+			},
+			want: []string{"- analyzers/folding rebuild"},
+		},
+		{
+			name:    "settings",
+			current: hotels,
+			desired: func(m map[string]any) {
+				delete(m, "corsOptions")
+				m["description"] = "Hotels"
+				m["semantic"] = map[string]any{"defaultConfiguration": "default"}
+			},
+			want: []string{"- corsOptions in-place", "+ description in-place", "+ semantic/defaultConfiguration in-place"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := parseIndex(t, edit(t, desiredHotels, tt.desired))
+			p, err := Diff(parseIndex(t, tt.current), desired)
+			if err != nil {
+				t.Fatalf("Diff() error = %v", err)
+			}
+			var got []string
+			for _, c := range p.Changes {
+				got = append(got, fmt.Sprintf("%s %s %s", c.Kind.symbol(), c.Path, c.Impact))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Diff() = %q, want %q\n%s", got, tt.want, p)
+			}
+		})
+	}
+}
+
+func TestDiffCreate(t *testing.T) {
+	p, err := Diff(nil, parseIndex(t, desiredHotels))
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if len(p.Changes) != 1 || p.Changes[0].Kind != Added || p.Changes[0].Path != "" {
+		t.Errorf("Diff() = %+v, want the creation of the index", p.Changes)
+	}
+	if _, err := Diff(nil, &searchservice.SearchIndex{}); err == nil {
+		t.Error("Diff() without name error = nil")
+	}
+}
+
+func TestPlanImpact(t *testing.T) {
+	tests := []struct {
+		name    string
+		changes []Change
+		want    Impact
+	}{
+		{"none", nil, InPlace},
+		{"in place", []Change{{Impact: InPlace}}, InPlace},
+		{"downtime", []Change{{Impact: InPlace}, {Impact: Downtime}}, Downtime},
+		{"rebuild", []Change{{Impact: Rebuild}, {Impact: Downtime}}, Rebuild},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Plan{Changes: tt.changes}
+			if got := p.Impact(); got != tt.want {
+				t.Errorf("Impact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+// Package migrate plans and applies changes to the definition of an existing index.
+//
+// IndexesClient.CreateOrUpdate rejects most changes to existing fields and analysis components,
+// and accepts new custom analyzers only with AllowIndexDowntime. Diff compares a desired index
+// definition with the live one and classifies each difference by how it can be applied, so the
+// plan can be reviewed before anything is changed:
+//
+//	plan, err := migrate.PlanFor(ctx, client.Indexes(), desired)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Print(plan)
+//	if plan.Impact() == migrate.Rebuild {
+//		// create a new index and copy the documents, e.g. with azaisearch.CopyIndex
+//	}
+//	err = plan.Apply(ctx, client.Indexes(), &migrate.ApplyOptions{AllowIndexDowntime: true})
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// Impact classifies how a change can be applied to an existing index.
+type Impact int
+
+const (
+	// InPlace changes are applied with CreateOrUpdate while the index stays online.
+	InPlace Impact = iota
+	// Downtime changes, such as new custom analyzers, need AllowIndexDowntime, which takes the
+	// index offline for at least a few seconds.
+	Downtime
+	// Rebuild changes, such as field type changes, can't be applied to the existing index. The
+	// index must be recreated and its documents reindexed.
+	Rebuild
+)
+
+func (i Impact) String() string {
+	switch i {
+	case InPlace:
+		return "in-place"
+	case Downtime:
+		return "downtime"
+	case Rebuild:
+		return "rebuild"
+	}
+	return fmt.Sprintf("Impact(%d)", int(i))
+}
+
+// Kind is the kind of a Change.
+type Kind int
+
+const (
+	// Added parts exist only in the desired definition.
+	Added Kind = iota
+	// Modified parts differ between the live and the desired definition.
+	Modified
+	// Removed parts exist only in the live definition.
+	Removed
+)
+
+// symbol returns the marker of the kind in a printed plan.
+func (k Kind) symbol() string {
+	switch k {
+	case Added:
+		return "+"
+	case Removed:
+		return "-"
+	}
+	return "~"
+}
+
+// Change is a difference between the live and the desired index definition.
+type Change struct {
+	// Path is the slash-separated path of the changed part of the definition, e.g. "fields/address/city",
+	// "analyzers/my_analyzer" or "corsOptions". It is empty for the creation of the index.
+	Path string
+
+	Kind   Kind
+	Impact Impact
+
+	// Description describes the change, e.g. "filterable: false -> true".
+	Description string
+}
+
+// Plan is the list of changes that migrate an index to a desired definition.
+type Plan struct {
+	// IndexName is the name of the index.
+	IndexName string
+
+	// Changes lists the differences, fields first.
+	Changes []Change
+
+	desired *searchservice.SearchIndex
+	current *searchservice.SearchIndex
+}
+
+func (p *Plan) add(path string, kind Kind, impact Impact, format string, args ...any) {
+	p.Changes = append(p.Changes, Change{Path: path, Kind: kind, Impact: impact, Description: fmt.Sprintf(format, args...)})
+}
+
+// Impact returns the highest impact of the changes, or InPlace if there are none.
+func (p *Plan) Impact() Impact {
+	impact := InPlace
+	for _, c := range p.Changes {
+		impact = max(impact, c.Impact)
+	}
+	return impact
+}
+
+// String returns the plan in a human-readable form, with the changes grouped by impact.
+func (p *Plan) String() string {
+	var b strings.Builder
+	switch {
+	case p.current == nil:
+		fmt.Fprintf(&b, "Index %q does not exist and will be created.\n", p.IndexName)
+		return b.String()
+	case len(p.Changes) == 0:
+		fmt.Fprintf(&b, "Index %q is up to date.\n", p.IndexName)
+		return b.String()
+	}
+	fmt.Fprintf(&b, "Index %q: %d change(s), %s.\n", p.IndexName, len(p.Changes), p.summary())
+	width := 0
+	for _, c := range p.Changes {
+		width = max(width, len(c.Path))
+	}
+	for _, impact := range []Impact{Rebuild, Downtime, InPlace} {
+		var lines []string
+		for _, c := range p.Changes {
+			if c.Impact == impact {
+				lines = append(lines, fmt.Sprintf("  %s %-*s  %s\n", c.Kind.symbol(), width, c.Path, c.Description))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n%s:\n", groupTitles[impact])
+		for _, l := range lines {
+			b.WriteString(l)
+		}
+	}
+	return b.String()
+}
+
+var groupTitles = map[Impact]string{
+	Rebuild:  "Needs a rebuild (the index must be recreated and reindexed)",
+	Downtime: "Needs downtime (applied with AllowIndexDowntime, the index is briefly offline)",
+	InPlace:  "In place",
+}
+
+func (p *Plan) summary() string {
+	switch p.Impact() {
+	case Rebuild:
+		return "a rebuild is required"
+	case Downtime:
+		return "can be applied with downtime"
+	}
+	return "can be applied in place"
+}
+
+// PlanFor fetches the live definition of the desired index with IndexesClient.Get and compares it
+// with desired, see Diff. If the index doesn't exist, the plan creates it.
+func PlanFor(ctx context.Context, indexes *searchservice.IndexesClient, desired searchservice.SearchIndex) (*Plan, error) {
+	if desired.Name == nil {
+		return nil, errors.New("desired index has no name")
+	}
+	resp, err := indexes.Get(ctx, *desired.Name, nil, nil)
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound {
+		return Diff(nil, &desired)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return Diff(&resp.SearchIndex, &desired)
+}
+
+// ApplyOptions contains the optional parameters for Plan.Apply.
+type ApplyOptions struct {
+	// AllowIndexDowntime allows applying plans with Downtime changes. Without it, such plans are rejected.
+	AllowIndexDowntime bool
+}
+
+// Apply applies the plan with IndexesClient.CreateOrUpdate. It fails without changing anything if
+// the plan needs a rebuild, or needs downtime that ApplyOptions doesn't allow. The update is
+// conditional on the ETag of the compared live index, so it fails if the index changed since.
+//   - indexes - the client of the service the plan was made for
+//   - options - ApplyOptions contains the optional parameters, pass nil to accept the default values.
+func (p *Plan) Apply(ctx context.Context, indexes *searchservice.IndexesClient, options *ApplyOptions) error {
+	if options == nil {
+		options = &ApplyOptions{}
+	}
+	if p.current == nil {
+		_, err := indexes.Create(ctx, *p.desired, nil, nil)
+		return err
+	}
+	if len(p.Changes) == 0 {
+		return nil
+	}
+	switch p.Impact() {
+	case Rebuild:
+		var paths []string
+		for _, c := range p.Changes {
+			if c.Impact == Rebuild {
+				paths = append(paths, c.Path)
+			}
+		}
+		return fmt.Errorf("index %q needs a rebuild for: %s", p.IndexName, strings.Join(paths, ", "))
+	case Downtime:
+		if !options.AllowIndexDowntime {
+			return fmt.Errorf("index %q can only be updated with downtime; set AllowIndexDowntime", p.IndexName)
+		}
+	}
+
+	index, err := mergeFieldAttributes(p.current, p.desired)
+	if err != nil {
+		return err
+	}
+	updateOptions := &searchservice.IndexesClientCreateOrUpdateOptions{IfMatch: p.current.ETag}
+	if p.Impact() == Downtime {
+		updateOptions.AllowIndexDowntime = &options.AllowIndexDowntime
+	}
+	_, err = indexes.CreateOrUpdate(ctx, p.IndexName, searchservice.Enum0ReturnRepresentation, *index, updateOptions, nil)
+	return err
+}
+
+// mergeFieldAttributes returns a copy of desired whose unset field attributes are taken from the
+// fields of the same name in current, so they aren't reset to the service defaults. Unset kept
+// settings, such as the similarity algorithm, are taken from current too.
+func mergeFieldAttributes(current, desired *searchservice.SearchIndex) (*searchservice.SearchIndex, error) {
+	cur, err := toMap(current)
+	if err != nil {
+		return nil, err
+	}
+	des, err := toMap(desired)
+	if err != nil {
+		return nil, err
+	}
+	mergeFields(list(cur["fields"]), list(des["fields"]))
+	for key := range keptSettings {
+		if des[key] == nil && cur[key] != nil {
+			des[key] = cur[key]
+		}
+	}
+	delete(des, "@odata.etag")
+	b, err := json.Marshal(des)
+	if err != nil {
+		return nil, err
+	}
+	var merged searchservice.SearchIndex
+	if err := json.Unmarshal(b, &merged); err != nil {
+		return nil, err
+	}
+	return &merged, nil
+}
+
+func mergeFields(current, desired []any) {
+	byName := index(current)
+	for _, d := range desired {
+		df, ok := d.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := df["name"].(string)
+		cf, ok := byName[name]
+		if !ok {
+			continue
+		}
+		for attr, v := range cf {
+			if _, set := df[attr]; !set && attr != "fields" {
+				df[attr] = v
+			}
+		}
+		mergeFields(list(cf["fields"]), list(df["fields"]))
+	}
+}
@@ -0,0 +1,120 @@
+package migrate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeFieldAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired func(m map[string]any)
+		check   func(t *testing.T, m map[string]any)
+	}{
+		{
+			name:    "unset attributes from current",
+			desired: func(m map[string]any) {},
+			check: func(t *testing.T, m map[string]any) {
+				want := map[string]any{
+					"name": "name", "type": "Edm.String", "searchable": true, "filterable": false, "retrievable": true,
+					"stored": true, "sortable": true, "facetable": false, "analyzer": "en.microsoft", "synonymMaps": []any{},
+				}
+				if got := field(m, "name"); !reflect.DeepEqual(got, want) {
+					t.Errorf("field name = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name: "set attributes kept",
+			desired: func(m map[string]any) {
+				f := field(m, "name")
+				f["retrievable"] = false
+				f["synonymMaps"] = []any{"hotel-synonyms"}
+			},
+			check: func(t *testing.T, m map[string]any) {
+				f := field(m, "name")
+				if f["retrievable"] != false || !reflect.DeepEqual(f["synonymMaps"], []any{"hotel-synonyms"}) {
+					t.Errorf("field name = %v, want the desired attributes", f)
+				}
+			},
+		},
+		{
+			name:    "subfields",
+			desired: func(m map[string]any) {},
+			check: func(t *testing.T, m map[string]any) {
+				f := field(m, "address", "city")
+				if f["searchable"] != true || f["retrievable"] != true {
+					t.Errorf("field address/city = %v, want the current attributes", f)
+				}
+			},
+		},
+		{
+			name: "new fields unchanged",
+			desired: func(m map[string]any) {
+				m["fields"] = append(list(m["fields"]), map[string]any{"name": "rating", "type": "Edm.Double"})
+			},
+			check: func(t *testing.T, m map[string]any) {
+				want := map[string]any{"name": "rating", "type": "Edm.Double"}
+				if got := field(m, "rating"); !reflect.DeepEqual(got, want) {
+					t.Errorf("field rating = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name:    "kept settings from current",
+			desired: func(m map[string]any) {},
+			check: func(t *testing.T, m map[string]any) {
+				want := map[string]any{"@odata.type": "#Microsoft.Azure.Search.BM25Similarity"}
+				if !reflect.DeepEqual(m["similarity"], want) {
+					t.Errorf("similarity = %v, want %v", m["similarity"], want)
+				}
+				if _, ok := m["@odata.etag"]; ok {
+					t.Error("ETag is set")
+				}
+			},
+		},
+		{
+			name: "removed settings stay removed",
+			desired: func(m map[string]any) {
+				delete(m, "corsOptions")
+			},
+			check: func(t *testing.T, m map[string]any) {
+				if m["corsOptions"] != nil {
+					t.Errorf("corsOptions = %v, want unset", m["corsOptions"])
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := parseIndex(t, edit(t, desiredHotels, tt.desired))
+			merged, err := mergeFieldAttributes(parseIndex(t, hotels), desired)
+			if err != nil {
+				t.Fatalf("mergeFieldAttributes() error = %v", err)
+			}
+			m, err := toMap(merged)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, m)
+		})
+	}
+}
+
+func TestMergeFieldAttributesKeepsDesired(t *testing.T) {
+	desired := parseIndex(t, desiredHotels)
+	before, err := toMap(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mergeFieldAttributes(parseIndex(t, hotels), desired); err != nil {
+		t.Fatalf("mergeFieldAttributes() error = %v", err)
+	}
+	after, err := toMap(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(before, after) {
+		t.Errorf("mergeFieldAttributes() modified desired: %v, was %v", after, before)
+	}
+}
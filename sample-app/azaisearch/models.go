@@ -1,19 +1,7 @@
 package azaisearch
 
-import (
-	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
-	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
-)
+// The models, enums and client types of the generated searchindex and searchservice
+// packages are re-exported in models_gen.go so callers don't need to import internal
+// packages. Regenerate it whenever the generated packages change.
 
-type SearchIndex = searchservice.SearchIndex
-type SearchField = searchservice.SearchField
-type IndexBatch = searchindex.IndexBatch
-type IndexAction = searchindex.IndexAction
-type DocumentsClientSearchGetOptions = searchindex.DocumentsClientSearchGetOptions
-
-// Public client type aliases so callers don't need to import internal packages
-type IndexesClient = searchservice.IndexesClient
-type DocumentsClient = searchindex.DocumentsClient
-
-const SearchFieldDataTypeString = searchservice.SearchFieldDataTypeString
-const IndexActionTypeUpload = searchindex.IndexActionTypeUpload
+//go:generate go run ./internal/cmd/genmodels
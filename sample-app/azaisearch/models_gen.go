@@ -0,0 +1,1797 @@
+// Code generated by genmodels. DO NOT EDIT.
+
+package azaisearch
+
+import (
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// Types re-exported from searchservice.
+type ASCIIFoldingTokenFilter = searchservice.ASCIIFoldingTokenFilter
+type AnalyzeRequest = searchservice.AnalyzeRequest
+type AnalyzeResult = searchservice.AnalyzeResult
+type AnalyzedTokenInfo = searchservice.AnalyzedTokenInfo
+type AzureActiveDirectoryApplicationCredentials = searchservice.AzureActiveDirectoryApplicationCredentials
+type AzureOpenAIEmbeddingSkill = searchservice.AzureOpenAIEmbeddingSkill
+type AzureOpenAIModelName = searchservice.AzureOpenAIModelName
+type AzureOpenAIParameters = searchservice.AzureOpenAIParameters
+type AzureOpenAIVectorizer = searchservice.AzureOpenAIVectorizer
+type BM25Similarity = searchservice.BM25Similarity
+type BinaryQuantizationCompressionConfiguration = searchservice.BinaryQuantizationCompressionConfiguration
+type BlobIndexerDataToExtract = searchservice.BlobIndexerDataToExtract
+type BlobIndexerImageAction = searchservice.BlobIndexerImageAction
+type BlobIndexerPDFTextRotationAlgorithm = searchservice.BlobIndexerPDFTextRotationAlgorithm
+type BlobIndexerParsingMode = searchservice.BlobIndexerParsingMode
+type CharFilter = searchservice.CharFilter
+type CharFilterClassification = searchservice.CharFilterClassification
+type CharFilterName = searchservice.CharFilterName
+type CjkBigramTokenFilter = searchservice.CjkBigramTokenFilter
+type CjkBigramTokenFilterScripts = searchservice.CjkBigramTokenFilterScripts
+type ClassicSimilarity = searchservice.ClassicSimilarity
+type ClassicTokenizer = searchservice.ClassicTokenizer
+type CognitiveServicesAccount = searchservice.CognitiveServicesAccount
+type CognitiveServicesAccountClassification = searchservice.CognitiveServicesAccountClassification
+type CognitiveServicesAccountKey = searchservice.CognitiveServicesAccountKey
+type CommonGramTokenFilter = searchservice.CommonGramTokenFilter
+type ConditionalSkill = searchservice.ConditionalSkill
+type CorsOptions = searchservice.CorsOptions
+type CustomAnalyzer = searchservice.CustomAnalyzer
+type CustomEntity = searchservice.CustomEntity
+type CustomEntityAlias = searchservice.CustomEntityAlias
+type CustomEntityLookupSkill = searchservice.CustomEntityLookupSkill
+type CustomEntityLookupSkillLanguage = searchservice.CustomEntityLookupSkillLanguage
+type CustomNormalizer = searchservice.CustomNormalizer
+type DataChangeDetectionPolicy = searchservice.DataChangeDetectionPolicy
+type DataChangeDetectionPolicyClassification = searchservice.DataChangeDetectionPolicyClassification
+type DataDeletionDetectionPolicy = searchservice.DataDeletionDetectionPolicy
+type DataDeletionDetectionPolicyClassification = searchservice.DataDeletionDetectionPolicyClassification
+type DataSourceCredentials = searchservice.DataSourceCredentials
+type DataSourcesClient = searchservice.DataSourcesClient
+type DataSourcesClientCreateOptions = searchservice.DataSourcesClientCreateOptions
+type DataSourcesClientCreateOrUpdateOptions = searchservice.DataSourcesClientCreateOrUpdateOptions
+type DataSourcesClientCreateOrUpdateResponse = searchservice.DataSourcesClientCreateOrUpdateResponse
+type DataSourcesClientCreateResponse = searchservice.DataSourcesClientCreateResponse
+type DataSourcesClientDeleteOptions = searchservice.DataSourcesClientDeleteOptions
+type DataSourcesClientDeleteResponse = searchservice.DataSourcesClientDeleteResponse
+type DataSourcesClientGetOptions = searchservice.DataSourcesClientGetOptions
+type DataSourcesClientGetResponse = searchservice.DataSourcesClientGetResponse
+type DataSourcesClientListOptions = searchservice.DataSourcesClientListOptions
+type DataSourcesClientListResponse = searchservice.DataSourcesClientListResponse
+type DefaultCognitiveServicesAccount = searchservice.DefaultCognitiveServicesAccount
+type DictionaryDecompounderTokenFilter = searchservice.DictionaryDecompounderTokenFilter
+type DistanceScoringFunction = searchservice.DistanceScoringFunction
+type DistanceScoringParameters = searchservice.DistanceScoringParameters
+type DocumentExtractionSkill = searchservice.DocumentExtractionSkill
+type DocumentIntelligenceLayoutSkill = searchservice.DocumentIntelligenceLayoutSkill
+type DocumentIntelligenceLayoutSkillChunkingProperties = searchservice.DocumentIntelligenceLayoutSkillChunkingProperties
+type DocumentIntelligenceLayoutSkillChunkingUnit = searchservice.DocumentIntelligenceLayoutSkillChunkingUnit
+type DocumentIntelligenceLayoutSkillExtractionOptions = searchservice.DocumentIntelligenceLayoutSkillExtractionOptions
+type DocumentIntelligenceLayoutSkillMarkdownHeaderDepth = searchservice.DocumentIntelligenceLayoutSkillMarkdownHeaderDepth
+type DocumentIntelligenceLayoutSkillOutputFormat = searchservice.DocumentIntelligenceLayoutSkillOutputFormat
+type DocumentIntelligenceLayoutSkillOutputMode = searchservice.DocumentIntelligenceLayoutSkillOutputMode
+type EdgeNGramTokenFilter = searchservice.EdgeNGramTokenFilter
+type EdgeNGramTokenFilterSide = searchservice.EdgeNGramTokenFilterSide
+type EdgeNGramTokenFilterV2 = searchservice.EdgeNGramTokenFilterV2
+type EdgeNGramTokenizer = searchservice.EdgeNGramTokenizer
+type ElisionTokenFilter = searchservice.ElisionTokenFilter
+type EntityCategory = searchservice.EntityCategory
+type EntityLinkingSkill = searchservice.EntityLinkingSkill
+type EntityRecognitionSkill = searchservice.EntityRecognitionSkill
+type EntityRecognitionSkillLanguage = searchservice.EntityRecognitionSkillLanguage
+type EntityRecognitionSkillV3 = searchservice.EntityRecognitionSkillV3
+type Enum0 = searchservice.Enum0
+type ErrorAdditionalInfo = searchservice.ErrorAdditionalInfo
+type ErrorDetail = searchservice.ErrorDetail
+type ErrorResponse = searchservice.ErrorResponse
+type ExhaustiveKnnAlgorithmConfiguration = searchservice.ExhaustiveKnnAlgorithmConfiguration
+type ExhaustiveKnnParameters = searchservice.ExhaustiveKnnParameters
+type FieldMapping = searchservice.FieldMapping
+type FieldMappingFunction = searchservice.FieldMappingFunction
+type FreshnessScoringFunction = searchservice.FreshnessScoringFunction
+type FreshnessScoringParameters = searchservice.FreshnessScoringParameters
+type GetIndexStatisticsResult = searchservice.GetIndexStatisticsResult
+type HighWaterMarkChangeDetectionPolicy = searchservice.HighWaterMarkChangeDetectionPolicy
+type HnswAlgorithmConfiguration = searchservice.HnswAlgorithmConfiguration
+type HnswParameters = searchservice.HnswParameters
+type ImageAnalysisSkill = searchservice.ImageAnalysisSkill
+type ImageAnalysisSkillLanguage = searchservice.ImageAnalysisSkillLanguage
+type ImageDetail = searchservice.ImageDetail
+type IndexProjectionMode = searchservice.IndexProjectionMode
+type IndexerExecutionEnvironment = searchservice.IndexerExecutionEnvironment
+type IndexerExecutionResult = searchservice.IndexerExecutionResult
+type IndexerExecutionStatus = searchservice.IndexerExecutionStatus
+type IndexerStatus = searchservice.IndexerStatus
+type IndexersClient = searchservice.IndexersClient
+type IndexersClientCreateOptions = searchservice.IndexersClientCreateOptions
+type IndexersClientCreateOrUpdateOptions = searchservice.IndexersClientCreateOrUpdateOptions
+type IndexersClientCreateOrUpdateResponse = searchservice.IndexersClientCreateOrUpdateResponse
+type IndexersClientCreateResponse = searchservice.IndexersClientCreateResponse
+type IndexersClientDeleteOptions = searchservice.IndexersClientDeleteOptions
+type IndexersClientDeleteResponse = searchservice.IndexersClientDeleteResponse
+type IndexersClientGetOptions = searchservice.IndexersClientGetOptions
+type IndexersClientGetResponse = searchservice.IndexersClientGetResponse
+type IndexersClientGetStatusOptions = searchservice.IndexersClientGetStatusOptions
+type IndexersClientGetStatusResponse = searchservice.IndexersClientGetStatusResponse
+type IndexersClientListOptions = searchservice.IndexersClientListOptions
+type IndexersClientListResponse = searchservice.IndexersClientListResponse
+type IndexersClientResetOptions = searchservice.IndexersClientResetOptions
+type IndexersClientResetResponse = searchservice.IndexersClientResetResponse
+type IndexersClientRunOptions = searchservice.IndexersClientRunOptions
+type IndexersClientRunResponse = searchservice.IndexersClientRunResponse
+type IndexesClient = searchservice.IndexesClient
+type IndexesClientAnalyzeOptions = searchservice.IndexesClientAnalyzeOptions
+type IndexesClientAnalyzeResponse = searchservice.IndexesClientAnalyzeResponse
+type IndexesClientCreateOptions = searchservice.IndexesClientCreateOptions
+type IndexesClientCreateOrUpdateOptions = searchservice.IndexesClientCreateOrUpdateOptions
+type IndexesClientCreateOrUpdateResponse = searchservice.IndexesClientCreateOrUpdateResponse
+type IndexesClientCreateResponse = searchservice.IndexesClientCreateResponse
+type IndexesClientDeleteOptions = searchservice.IndexesClientDeleteOptions
+type IndexesClientDeleteResponse = searchservice.IndexesClientDeleteResponse
+type IndexesClientGetOptions = searchservice.IndexesClientGetOptions
+type IndexesClientGetResponse = searchservice.IndexesClientGetResponse
+type IndexesClientGetStatisticsOptions = searchservice.IndexesClientGetStatisticsOptions
+type IndexesClientGetStatisticsResponse = searchservice.IndexesClientGetStatisticsResponse
+type IndexesClientListOptions = searchservice.IndexesClientListOptions
+type IndexesClientListResponse = searchservice.IndexesClientListResponse
+type IndexingParameters = searchservice.IndexingParameters
+type IndexingParametersConfiguration = searchservice.IndexingParametersConfiguration
+type IndexingSchedule = searchservice.IndexingSchedule
+type InputFieldMappingEntry = searchservice.InputFieldMappingEntry
+type KeepTokenFilter = searchservice.KeepTokenFilter
+type KeyPhraseExtractionSkill = searchservice.KeyPhraseExtractionSkill
+type KeyPhraseExtractionSkillLanguage = searchservice.KeyPhraseExtractionSkillLanguage
+type KeywordMarkerTokenFilter = searchservice.KeywordMarkerTokenFilter
+type KeywordTokenizer = searchservice.KeywordTokenizer
+type KeywordTokenizerV2 = searchservice.KeywordTokenizerV2
+type LanguageDetectionSkill = searchservice.LanguageDetectionSkill
+type LengthTokenFilter = searchservice.LengthTokenFilter
+type LexicalAnalyzer = searchservice.LexicalAnalyzer
+type LexicalAnalyzerClassification = searchservice.LexicalAnalyzerClassification
+type LexicalAnalyzerName = searchservice.LexicalAnalyzerName
+type LexicalNormalizer = searchservice.LexicalNormalizer
+type LexicalNormalizerClassification = searchservice.LexicalNormalizerClassification
+type LexicalNormalizerName = searchservice.LexicalNormalizerName
+type LexicalTokenizer = searchservice.LexicalTokenizer
+type LexicalTokenizerClassification = searchservice.LexicalTokenizerClassification
+type LexicalTokenizerName = searchservice.LexicalTokenizerName
+type LimitTokenFilter = searchservice.LimitTokenFilter
+type LineEnding = searchservice.LineEnding
+type ListDataSourcesResult = searchservice.ListDataSourcesResult
+type ListIndexersResult = searchservice.ListIndexersResult
+type ListIndexesResult = searchservice.ListIndexesResult
+type ListSkillsetsResult = searchservice.ListSkillsetsResult
+type ListSynonymMapsResult = searchservice.ListSynonymMapsResult
+type LuceneStandardAnalyzer = searchservice.LuceneStandardAnalyzer
+type LuceneStandardTokenizer = searchservice.LuceneStandardTokenizer
+type LuceneStandardTokenizerV2 = searchservice.LuceneStandardTokenizerV2
+type MagnitudeScoringFunction = searchservice.MagnitudeScoringFunction
+type MagnitudeScoringParameters = searchservice.MagnitudeScoringParameters
+type MappingCharFilter = searchservice.MappingCharFilter
+type MergeSkill = searchservice.MergeSkill
+type MicrosoftLanguageStemmingTokenizer = searchservice.MicrosoftLanguageStemmingTokenizer
+type MicrosoftLanguageTokenizer = searchservice.MicrosoftLanguageTokenizer
+type MicrosoftStemmingTokenizerLanguage = searchservice.MicrosoftStemmingTokenizerLanguage
+type MicrosoftTokenizerLanguage = searchservice.MicrosoftTokenizerLanguage
+type NGramTokenFilter = searchservice.NGramTokenFilter
+type NGramTokenFilterV2 = searchservice.NGramTokenFilterV2
+type NGramTokenizer = searchservice.NGramTokenizer
+type OcrSkill = searchservice.OcrSkill
+type OcrSkillLanguage = searchservice.OcrSkillLanguage
+type OutputFieldMappingEntry = searchservice.OutputFieldMappingEntry
+type PIIDetectionSkill = searchservice.PIIDetectionSkill
+type PIIDetectionSkillMaskingMode = searchservice.PIIDetectionSkillMaskingMode
+type PathHierarchyTokenizerV2 = searchservice.PathHierarchyTokenizerV2
+type PatternAnalyzer = searchservice.PatternAnalyzer
+type PatternCaptureTokenFilter = searchservice.PatternCaptureTokenFilter
+type PatternReplaceCharFilter = searchservice.PatternReplaceCharFilter
+type PatternReplaceTokenFilter = searchservice.PatternReplaceTokenFilter
+type PatternTokenizer = searchservice.PatternTokenizer
+type PhoneticEncoder = searchservice.PhoneticEncoder
+type PhoneticTokenFilter = searchservice.PhoneticTokenFilter
+type RankingOrder = searchservice.RankingOrder
+type RegexFlags = searchservice.RegexFlags
+type RequestOptions = searchservice.RequestOptions
+type RescoringOptions = searchservice.RescoringOptions
+type ResourceCounter = searchservice.ResourceCounter
+type SQLIntegratedChangeTrackingPolicy = searchservice.SQLIntegratedChangeTrackingPolicy
+type ScalarQuantizationCompressionConfiguration = searchservice.ScalarQuantizationCompressionConfiguration
+type ScalarQuantizationParameters = searchservice.ScalarQuantizationParameters
+type ScoringFunction = searchservice.ScoringFunction
+type ScoringFunctionAggregation = searchservice.ScoringFunctionAggregation
+type ScoringFunctionClassification = searchservice.ScoringFunctionClassification
+type ScoringFunctionInterpolation = searchservice.ScoringFunctionInterpolation
+type ScoringProfile = searchservice.ScoringProfile
+type SearchClient = searchservice.SearchClient
+type SearchClientGetServiceStatisticsOptions = searchservice.SearchClientGetServiceStatisticsOptions
+type SearchClientGetServiceStatisticsResponse = searchservice.SearchClientGetServiceStatisticsResponse
+type SearchField = searchservice.SearchField
+type SearchFieldDataType = searchservice.SearchFieldDataType
+type SearchIndex = searchservice.SearchIndex
+type SearchIndexer = searchservice.SearchIndexer
+type SearchIndexerDataContainer = searchservice.SearchIndexerDataContainer
+type SearchIndexerDataIdentity = searchservice.SearchIndexerDataIdentity
+type SearchIndexerDataIdentityClassification = searchservice.SearchIndexerDataIdentityClassification
+type SearchIndexerDataNoneIdentity = searchservice.SearchIndexerDataNoneIdentity
+type SearchIndexerDataSource = searchservice.SearchIndexerDataSource
+type SearchIndexerDataSourceType = searchservice.SearchIndexerDataSourceType
+type SearchIndexerDataUserAssignedIdentity = searchservice.SearchIndexerDataUserAssignedIdentity
+type SearchIndexerError = searchservice.SearchIndexerError
+type SearchIndexerIndexProjectionSelector = searchservice.SearchIndexerIndexProjectionSelector
+type SearchIndexerIndexProjections = searchservice.SearchIndexerIndexProjections
+type SearchIndexerIndexProjectionsParameters = searchservice.SearchIndexerIndexProjectionsParameters
+type SearchIndexerKnowledgeStore = searchservice.SearchIndexerKnowledgeStore
+type SearchIndexerKnowledgeStoreBlobProjectionSelector = searchservice.SearchIndexerKnowledgeStoreBlobProjectionSelector
+type SearchIndexerKnowledgeStoreFileProjectionSelector = searchservice.SearchIndexerKnowledgeStoreFileProjectionSelector
+type SearchIndexerKnowledgeStoreObjectProjectionSelector = searchservice.SearchIndexerKnowledgeStoreObjectProjectionSelector
+type SearchIndexerKnowledgeStoreParameters = searchservice.SearchIndexerKnowledgeStoreParameters
+type SearchIndexerKnowledgeStoreProjection = searchservice.SearchIndexerKnowledgeStoreProjection
+type SearchIndexerKnowledgeStoreProjectionSelector = searchservice.SearchIndexerKnowledgeStoreProjectionSelector
+type SearchIndexerKnowledgeStoreTableProjectionSelector = searchservice.SearchIndexerKnowledgeStoreTableProjectionSelector
+type SearchIndexerLimits = searchservice.SearchIndexerLimits
+type SearchIndexerSkill = searchservice.SearchIndexerSkill
+type SearchIndexerSkillClassification = searchservice.SearchIndexerSkillClassification
+type SearchIndexerSkillset = searchservice.SearchIndexerSkillset
+type SearchIndexerStatus = searchservice.SearchIndexerStatus
+type SearchIndexerWarning = searchservice.SearchIndexerWarning
+type SearchResourceEncryptionKey = searchservice.SearchResourceEncryptionKey
+type SemanticConfiguration = searchservice.SemanticConfiguration
+type SemanticField = searchservice.SemanticField
+type SemanticPrioritizedFields = searchservice.SemanticPrioritizedFields
+type SemanticSearch = searchservice.SemanticSearch
+type SentimentSkill = searchservice.SentimentSkill
+type SentimentSkillLanguage = searchservice.SentimentSkillLanguage
+type SentimentSkillV3 = searchservice.SentimentSkillV3
+type ServiceCounters = searchservice.ServiceCounters
+type ServiceLimits = searchservice.ServiceLimits
+type ServiceStatistics = searchservice.ServiceStatistics
+type ShaperSkill = searchservice.ShaperSkill
+type ShingleTokenFilter = searchservice.ShingleTokenFilter
+type Similarity = searchservice.Similarity
+type SimilarityClassification = searchservice.SimilarityClassification
+type SkillsetsClient = searchservice.SkillsetsClient
+type SkillsetsClientCreateOptions = searchservice.SkillsetsClientCreateOptions
+type SkillsetsClientCreateOrUpdateOptions = searchservice.SkillsetsClientCreateOrUpdateOptions
+type SkillsetsClientCreateOrUpdateResponse = searchservice.SkillsetsClientCreateOrUpdateResponse
+type SkillsetsClientCreateResponse = searchservice.SkillsetsClientCreateResponse
+type SkillsetsClientDeleteOptions = searchservice.SkillsetsClientDeleteOptions
+type SkillsetsClientDeleteResponse = searchservice.SkillsetsClientDeleteResponse
+type SkillsetsClientGetOptions = searchservice.SkillsetsClientGetOptions
+type SkillsetsClientGetResponse = searchservice.SkillsetsClientGetResponse
+type SkillsetsClientListOptions = searchservice.SkillsetsClientListOptions
+type SkillsetsClientListResponse = searchservice.SkillsetsClientListResponse
+type SnowballTokenFilter = searchservice.SnowballTokenFilter
+type SnowballTokenFilterLanguage = searchservice.SnowballTokenFilterLanguage
+type SoftDeleteColumnDeletionDetectionPolicy = searchservice.SoftDeleteColumnDeletionDetectionPolicy
+type SplitSkill = searchservice.SplitSkill
+type SplitSkillLanguage = searchservice.SplitSkillLanguage
+type StemmerOverrideTokenFilter = searchservice.StemmerOverrideTokenFilter
+type StemmerTokenFilter = searchservice.StemmerTokenFilter
+type StemmerTokenFilterLanguage = searchservice.StemmerTokenFilterLanguage
+type StopAnalyzer = searchservice.StopAnalyzer
+type StopwordsList = searchservice.StopwordsList
+type StopwordsTokenFilter = searchservice.StopwordsTokenFilter
+type Suggester = searchservice.Suggester
+type SynonymMap = searchservice.SynonymMap
+type SynonymMapsClient = searchservice.SynonymMapsClient
+type SynonymMapsClientCreateOptions = searchservice.SynonymMapsClientCreateOptions
+type SynonymMapsClientCreateOrUpdateOptions = searchservice.SynonymMapsClientCreateOrUpdateOptions
+type SynonymMapsClientCreateOrUpdateResponse = searchservice.SynonymMapsClientCreateOrUpdateResponse
+type SynonymMapsClientCreateResponse = searchservice.SynonymMapsClientCreateResponse
+type SynonymMapsClientDeleteOptions = searchservice.SynonymMapsClientDeleteOptions
+type SynonymMapsClientDeleteResponse = searchservice.SynonymMapsClientDeleteResponse
+type SynonymMapsClientGetOptions = searchservice.SynonymMapsClientGetOptions
+type SynonymMapsClientGetResponse = searchservice.SynonymMapsClientGetResponse
+type SynonymMapsClientListOptions = searchservice.SynonymMapsClientListOptions
+type SynonymMapsClientListResponse = searchservice.SynonymMapsClientListResponse
+type SynonymTokenFilter = searchservice.SynonymTokenFilter
+type TagScoringFunction = searchservice.TagScoringFunction
+type TagScoringParameters = searchservice.TagScoringParameters
+type TextSplitMode = searchservice.TextSplitMode
+type TextTranslationSkill = searchservice.TextTranslationSkill
+type TextTranslationSkillLanguage = searchservice.TextTranslationSkillLanguage
+type TextWeights = searchservice.TextWeights
+type TokenCharacterKind = searchservice.TokenCharacterKind
+type TokenFilter = searchservice.TokenFilter
+type TokenFilterClassification = searchservice.TokenFilterClassification
+type TokenFilterName = searchservice.TokenFilterName
+type TruncateTokenFilter = searchservice.TruncateTokenFilter
+type UaxURLEmailTokenizer = searchservice.UaxURLEmailTokenizer
+type UniqueTokenFilter = searchservice.UniqueTokenFilter
+type VectorEncodingFormat = searchservice.VectorEncodingFormat
+type VectorSearch = searchservice.VectorSearch
+type VectorSearchAlgorithmConfiguration = searchservice.VectorSearchAlgorithmConfiguration
+type VectorSearchAlgorithmConfigurationClassification = searchservice.VectorSearchAlgorithmConfigurationClassification
+type VectorSearchAlgorithmKind = searchservice.VectorSearchAlgorithmKind
+type VectorSearchAlgorithmMetric = searchservice.VectorSearchAlgorithmMetric
+type VectorSearchCompressionConfiguration = searchservice.VectorSearchCompressionConfiguration
+type VectorSearchCompressionConfigurationClassification = searchservice.VectorSearchCompressionConfigurationClassification
+type VectorSearchCompressionKind = searchservice.VectorSearchCompressionKind
+type VectorSearchCompressionRescoreStorageMethod = searchservice.VectorSearchCompressionRescoreStorageMethod
+type VectorSearchCompressionTargetDataType = searchservice.VectorSearchCompressionTargetDataType
+type VectorSearchProfile = searchservice.VectorSearchProfile
+type VectorSearchVectorizer = searchservice.VectorSearchVectorizer
+type VectorSearchVectorizerClassification = searchservice.VectorSearchVectorizerClassification
+type VectorSearchVectorizerKind = searchservice.VectorSearchVectorizerKind
+type VisualFeature = searchservice.VisualFeature
+type WebAPIParameters = searchservice.WebAPIParameters
+type WebAPISkill = searchservice.WebAPISkill
+type WebAPIVectorizer = searchservice.WebAPIVectorizer
+type WordDelimiterTokenFilter = searchservice.WordDelimiterTokenFilter
+
+// AzureOpenAIModelName values re-exported from searchservice.
+const (
+	AzureOpenAIModelNameTextEmbedding3Large = searchservice.AzureOpenAIModelNameTextEmbedding3Large
+	AzureOpenAIModelNameTextEmbedding3Small = searchservice.AzureOpenAIModelNameTextEmbedding3Small
+	AzureOpenAIModelNameTextEmbeddingAda002 = searchservice.AzureOpenAIModelNameTextEmbeddingAda002
+)
+
+// BlobIndexerDataToExtract values re-exported from searchservice.
+const (
+	BlobIndexerDataToExtractAllMetadata        = searchservice.BlobIndexerDataToExtractAllMetadata
+	BlobIndexerDataToExtractContentAndMetadata = searchservice.BlobIndexerDataToExtractContentAndMetadata
+	BlobIndexerDataToExtractStorageMetadata    = searchservice.BlobIndexerDataToExtractStorageMetadata
+)
+
+// BlobIndexerImageAction values re-exported from searchservice.
+const (
+	BlobIndexerImageActionGenerateNormalizedImagePerPage = searchservice.BlobIndexerImageActionGenerateNormalizedImagePerPage
+	BlobIndexerImageActionGenerateNormalizedImages       = searchservice.BlobIndexerImageActionGenerateNormalizedImages
+	BlobIndexerImageActionNone                           = searchservice.BlobIndexerImageActionNone
+)
+
+// BlobIndexerPDFTextRotationAlgorithm values re-exported from searchservice.
+const (
+	BlobIndexerPDFTextRotationAlgorithmDetectAngles = searchservice.BlobIndexerPDFTextRotationAlgorithmDetectAngles
+	BlobIndexerPDFTextRotationAlgorithmNone         = searchservice.BlobIndexerPDFTextRotationAlgorithmNone
+)
+
+// BlobIndexerParsingMode values re-exported from searchservice.
+const (
+	BlobIndexerParsingModeDefault       = searchservice.BlobIndexerParsingModeDefault
+	BlobIndexerParsingModeDelimitedText = searchservice.BlobIndexerParsingModeDelimitedText
+	BlobIndexerParsingModeJSON          = searchservice.BlobIndexerParsingModeJSON
+	BlobIndexerParsingModeJSONArray     = searchservice.BlobIndexerParsingModeJSONArray
+	BlobIndexerParsingModeJSONLines     = searchservice.BlobIndexerParsingModeJSONLines
+	BlobIndexerParsingModeText          = searchservice.BlobIndexerParsingModeText
+)
+
+// CharFilterName values re-exported from searchservice.
+const (
+	CharFilterNameHTMLStrip = searchservice.CharFilterNameHTMLStrip
+)
+
+// CjkBigramTokenFilterScripts values re-exported from searchservice.
+const (
+	CjkBigramTokenFilterScriptsHan      = searchservice.CjkBigramTokenFilterScriptsHan
+	CjkBigramTokenFilterScriptsHangul   = searchservice.CjkBigramTokenFilterScriptsHangul
+	CjkBigramTokenFilterScriptsHiragana = searchservice.CjkBigramTokenFilterScriptsHiragana
+	CjkBigramTokenFilterScriptsKatakana = searchservice.CjkBigramTokenFilterScriptsKatakana
+)
+
+// CustomEntityLookupSkillLanguage values re-exported from searchservice.
+const (
+	CustomEntityLookupSkillLanguageDa = searchservice.CustomEntityLookupSkillLanguageDa
+	CustomEntityLookupSkillLanguageDe = searchservice.CustomEntityLookupSkillLanguageDe
+	CustomEntityLookupSkillLanguageEn = searchservice.CustomEntityLookupSkillLanguageEn
+	CustomEntityLookupSkillLanguageEs = searchservice.CustomEntityLookupSkillLanguageEs
+	CustomEntityLookupSkillLanguageFi = searchservice.CustomEntityLookupSkillLanguageFi
+	CustomEntityLookupSkillLanguageFr = searchservice.CustomEntityLookupSkillLanguageFr
+	CustomEntityLookupSkillLanguageIt = searchservice.CustomEntityLookupSkillLanguageIt
+	CustomEntityLookupSkillLanguageKo = searchservice.CustomEntityLookupSkillLanguageKo
+	CustomEntityLookupSkillLanguagePt = searchservice.CustomEntityLookupSkillLanguagePt
+)
+
+// DocumentIntelligenceLayoutSkillChunkingUnit values re-exported from searchservice.
+const (
+	DocumentIntelligenceLayoutSkillChunkingUnitCharacters = searchservice.DocumentIntelligenceLayoutSkillChunkingUnitCharacters
+)
+
+// DocumentIntelligenceLayoutSkillExtractionOptions values re-exported from searchservice.
+const (
+	DocumentIntelligenceLayoutSkillExtractionOptionsImages           = searchservice.DocumentIntelligenceLayoutSkillExtractionOptionsImages
+	DocumentIntelligenceLayoutSkillExtractionOptionsLocationMetadata = searchservice.DocumentIntelligenceLayoutSkillExtractionOptionsLocationMetadata
+)
+
+// DocumentIntelligenceLayoutSkillMarkdownHeaderDepth values re-exported from searchservice.
+const (
+	DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH1 = searchservice.DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH1
+	DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH2 = searchservice.DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH2
+	DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH3 = searchservice.DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH3
+	DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH4 = searchservice.DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH4
+	DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH5 = searchservice.DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH5
+	DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH6 = searchservice.DocumentIntelligenceLayoutSkillMarkdownHeaderDepthH6
+)
+
+// DocumentIntelligenceLayoutSkillOutputFormat values re-exported from searchservice.
+const (
+	DocumentIntelligenceLayoutSkillOutputFormatMarkdown = searchservice.DocumentIntelligenceLayoutSkillOutputFormatMarkdown
+	DocumentIntelligenceLayoutSkillOutputFormatText     = searchservice.DocumentIntelligenceLayoutSkillOutputFormatText
+)
+
+// DocumentIntelligenceLayoutSkillOutputMode values re-exported from searchservice.
+const (
+	DocumentIntelligenceLayoutSkillOutputModeOneToMany = searchservice.DocumentIntelligenceLayoutSkillOutputModeOneToMany
+)
+
+// EdgeNGramTokenFilterSide values re-exported from searchservice.
+const (
+	EdgeNGramTokenFilterSideBack  = searchservice.EdgeNGramTokenFilterSideBack
+	EdgeNGramTokenFilterSideFront = searchservice.EdgeNGramTokenFilterSideFront
+)
+
+// EntityCategory values re-exported from searchservice.
+const (
+	EntityCategoryDatetime     = searchservice.EntityCategoryDatetime
+	EntityCategoryEmail        = searchservice.EntityCategoryEmail
+	EntityCategoryLocation     = searchservice.EntityCategoryLocation
+	EntityCategoryOrganization = searchservice.EntityCategoryOrganization
+	EntityCategoryPerson       = searchservice.EntityCategoryPerson
+	EntityCategoryQuantity     = searchservice.EntityCategoryQuantity
+	EntityCategoryURL          = searchservice.EntityCategoryURL
+)
+
+// EntityRecognitionSkillLanguage values re-exported from searchservice.
+const (
+	EntityRecognitionSkillLanguageAr     = searchservice.EntityRecognitionSkillLanguageAr
+	EntityRecognitionSkillLanguageCs     = searchservice.EntityRecognitionSkillLanguageCs
+	EntityRecognitionSkillLanguageDa     = searchservice.EntityRecognitionSkillLanguageDa
+	EntityRecognitionSkillLanguageDe     = searchservice.EntityRecognitionSkillLanguageDe
+	EntityRecognitionSkillLanguageEl     = searchservice.EntityRecognitionSkillLanguageEl
+	EntityRecognitionSkillLanguageEn     = searchservice.EntityRecognitionSkillLanguageEn
+	EntityRecognitionSkillLanguageEs     = searchservice.EntityRecognitionSkillLanguageEs
+	EntityRecognitionSkillLanguageFi     = searchservice.EntityRecognitionSkillLanguageFi
+	EntityRecognitionSkillLanguageFr     = searchservice.EntityRecognitionSkillLanguageFr
+	EntityRecognitionSkillLanguageHu     = searchservice.EntityRecognitionSkillLanguageHu
+	EntityRecognitionSkillLanguageIt     = searchservice.EntityRecognitionSkillLanguageIt
+	EntityRecognitionSkillLanguageJa     = searchservice.EntityRecognitionSkillLanguageJa
+	EntityRecognitionSkillLanguageKo     = searchservice.EntityRecognitionSkillLanguageKo
+	EntityRecognitionSkillLanguageNl     = searchservice.EntityRecognitionSkillLanguageNl
+	EntityRecognitionSkillLanguageNo     = searchservice.EntityRecognitionSkillLanguageNo
+	EntityRecognitionSkillLanguagePl     = searchservice.EntityRecognitionSkillLanguagePl
+	EntityRecognitionSkillLanguagePtBR   = searchservice.EntityRecognitionSkillLanguagePtBR
+	EntityRecognitionSkillLanguagePtPT   = searchservice.EntityRecognitionSkillLanguagePtPT
+	EntityRecognitionSkillLanguageRu     = searchservice.EntityRecognitionSkillLanguageRu
+	EntityRecognitionSkillLanguageSv     = searchservice.EntityRecognitionSkillLanguageSv
+	EntityRecognitionSkillLanguageTr     = searchservice.EntityRecognitionSkillLanguageTr
+	EntityRecognitionSkillLanguageZhHans = searchservice.EntityRecognitionSkillLanguageZhHans
+	EntityRecognitionSkillLanguageZhHant = searchservice.EntityRecognitionSkillLanguageZhHant
+)
+
+// Enum0 values re-exported from searchservice.
+const (
+	Enum0ReturnRepresentation = searchservice.Enum0ReturnRepresentation
+)
+
+// ImageAnalysisSkillLanguage values re-exported from searchservice.
+const (
+	ImageAnalysisSkillLanguageAr     = searchservice.ImageAnalysisSkillLanguageAr
+	ImageAnalysisSkillLanguageAz     = searchservice.ImageAnalysisSkillLanguageAz
+	ImageAnalysisSkillLanguageBg     = searchservice.ImageAnalysisSkillLanguageBg
+	ImageAnalysisSkillLanguageBs     = searchservice.ImageAnalysisSkillLanguageBs
+	ImageAnalysisSkillLanguageCa     = searchservice.ImageAnalysisSkillLanguageCa
+	ImageAnalysisSkillLanguageCs     = searchservice.ImageAnalysisSkillLanguageCs
+	ImageAnalysisSkillLanguageCy     = searchservice.ImageAnalysisSkillLanguageCy
+	ImageAnalysisSkillLanguageDa     = searchservice.ImageAnalysisSkillLanguageDa
+	ImageAnalysisSkillLanguageDe     = searchservice.ImageAnalysisSkillLanguageDe
+	ImageAnalysisSkillLanguageEl     = searchservice.ImageAnalysisSkillLanguageEl
+	ImageAnalysisSkillLanguageEn     = searchservice.ImageAnalysisSkillLanguageEn
+	ImageAnalysisSkillLanguageEs     = searchservice.ImageAnalysisSkillLanguageEs
+	ImageAnalysisSkillLanguageEt     = searchservice.ImageAnalysisSkillLanguageEt
+	ImageAnalysisSkillLanguageEu     = searchservice.ImageAnalysisSkillLanguageEu
+	ImageAnalysisSkillLanguageFi     = searchservice.ImageAnalysisSkillLanguageFi
+	ImageAnalysisSkillLanguageFr     = searchservice.ImageAnalysisSkillLanguageFr
+	ImageAnalysisSkillLanguageGa     = searchservice.ImageAnalysisSkillLanguageGa
+	ImageAnalysisSkillLanguageGl     = searchservice.ImageAnalysisSkillLanguageGl
+	ImageAnalysisSkillLanguageHe     = searchservice.ImageAnalysisSkillLanguageHe
+	ImageAnalysisSkillLanguageHi     = searchservice.ImageAnalysisSkillLanguageHi
+	ImageAnalysisSkillLanguageHr     = searchservice.ImageAnalysisSkillLanguageHr
+	ImageAnalysisSkillLanguageHu     = searchservice.ImageAnalysisSkillLanguageHu
+	ImageAnalysisSkillLanguageID     = searchservice.ImageAnalysisSkillLanguageID
+	ImageAnalysisSkillLanguageIt     = searchservice.ImageAnalysisSkillLanguageIt
+	ImageAnalysisSkillLanguageJa     = searchservice.ImageAnalysisSkillLanguageJa
+	ImageAnalysisSkillLanguageKk     = searchservice.ImageAnalysisSkillLanguageKk
+	ImageAnalysisSkillLanguageKo     = searchservice.ImageAnalysisSkillLanguageKo
+	ImageAnalysisSkillLanguageLt     = searchservice.ImageAnalysisSkillLanguageLt
+	ImageAnalysisSkillLanguageLv     = searchservice.ImageAnalysisSkillLanguageLv
+	ImageAnalysisSkillLanguageMk     = searchservice.ImageAnalysisSkillLanguageMk
+	ImageAnalysisSkillLanguageMs     = searchservice.ImageAnalysisSkillLanguageMs
+	ImageAnalysisSkillLanguageNb     = searchservice.ImageAnalysisSkillLanguageNb
+	ImageAnalysisSkillLanguageNl     = searchservice.ImageAnalysisSkillLanguageNl
+	ImageAnalysisSkillLanguagePl     = searchservice.ImageAnalysisSkillLanguagePl
+	ImageAnalysisSkillLanguagePrs    = searchservice.ImageAnalysisSkillLanguagePrs
+	ImageAnalysisSkillLanguagePt     = searchservice.ImageAnalysisSkillLanguagePt
+	ImageAnalysisSkillLanguagePtBR   = searchservice.ImageAnalysisSkillLanguagePtBR
+	ImageAnalysisSkillLanguagePtPT   = searchservice.ImageAnalysisSkillLanguagePtPT
+	ImageAnalysisSkillLanguageRo     = searchservice.ImageAnalysisSkillLanguageRo
+	ImageAnalysisSkillLanguageRu     = searchservice.ImageAnalysisSkillLanguageRu
+	ImageAnalysisSkillLanguageSk     = searchservice.ImageAnalysisSkillLanguageSk
+	ImageAnalysisSkillLanguageSl     = searchservice.ImageAnalysisSkillLanguageSl
+	ImageAnalysisSkillLanguageSrCyrl = searchservice.ImageAnalysisSkillLanguageSrCyrl
+	ImageAnalysisSkillLanguageSrLatn = searchservice.ImageAnalysisSkillLanguageSrLatn
+	ImageAnalysisSkillLanguageSv     = searchservice.ImageAnalysisSkillLanguageSv
+	ImageAnalysisSkillLanguageTh     = searchservice.ImageAnalysisSkillLanguageTh
+	ImageAnalysisSkillLanguageTr     = searchservice.ImageAnalysisSkillLanguageTr
+	ImageAnalysisSkillLanguageUk     = searchservice.ImageAnalysisSkillLanguageUk
+	ImageAnalysisSkillLanguageVi     = searchservice.ImageAnalysisSkillLanguageVi
+	ImageAnalysisSkillLanguageZh     = searchservice.ImageAnalysisSkillLanguageZh
+	ImageAnalysisSkillLanguageZhHans = searchservice.ImageAnalysisSkillLanguageZhHans
+	ImageAnalysisSkillLanguageZhHant = searchservice.ImageAnalysisSkillLanguageZhHant
+)
+
+// ImageDetail values re-exported from searchservice.
+const (
+	ImageDetailCelebrities = searchservice.ImageDetailCelebrities
+	ImageDetailLandmarks   = searchservice.ImageDetailLandmarks
+)
+
+// IndexProjectionMode values re-exported from searchservice.
+const (
+	IndexProjectionModeIncludeIndexingParentDocuments = searchservice.IndexProjectionModeIncludeIndexingParentDocuments
+	IndexProjectionModeSkipIndexingParentDocuments    = searchservice.IndexProjectionModeSkipIndexingParentDocuments
+)
+
+// IndexerExecutionEnvironment values re-exported from searchservice.
+const (
+	IndexerExecutionEnvironmentPrivate  = searchservice.IndexerExecutionEnvironmentPrivate
+	IndexerExecutionEnvironmentStandard = searchservice.IndexerExecutionEnvironmentStandard
+)
+
+// IndexerExecutionStatus values re-exported from searchservice.
+const (
+	IndexerExecutionStatusInProgress       = searchservice.IndexerExecutionStatusInProgress
+	IndexerExecutionStatusReset            = searchservice.IndexerExecutionStatusReset
+	IndexerExecutionStatusSuccess          = searchservice.IndexerExecutionStatusSuccess
+	IndexerExecutionStatusTransientFailure = searchservice.IndexerExecutionStatusTransientFailure
+)
+
+// IndexerStatus values re-exported from searchservice.
+const (
+	IndexerStatusError   = searchservice.IndexerStatusError
+	IndexerStatusRunning = searchservice.IndexerStatusRunning
+	IndexerStatusUnknown = searchservice.IndexerStatusUnknown
+)
+
+// KeyPhraseExtractionSkillLanguage values re-exported from searchservice.
+const (
+	KeyPhraseExtractionSkillLanguageDa   = searchservice.KeyPhraseExtractionSkillLanguageDa
+	KeyPhraseExtractionSkillLanguageDe   = searchservice.KeyPhraseExtractionSkillLanguageDe
+	KeyPhraseExtractionSkillLanguageEn   = searchservice.KeyPhraseExtractionSkillLanguageEn
+	KeyPhraseExtractionSkillLanguageEs   = searchservice.KeyPhraseExtractionSkillLanguageEs
+	KeyPhraseExtractionSkillLanguageFi   = searchservice.KeyPhraseExtractionSkillLanguageFi
+	KeyPhraseExtractionSkillLanguageFr   = searchservice.KeyPhraseExtractionSkillLanguageFr
+	KeyPhraseExtractionSkillLanguageIt   = searchservice.KeyPhraseExtractionSkillLanguageIt
+	KeyPhraseExtractionSkillLanguageJa   = searchservice.KeyPhraseExtractionSkillLanguageJa
+	KeyPhraseExtractionSkillLanguageKo   = searchservice.KeyPhraseExtractionSkillLanguageKo
+	KeyPhraseExtractionSkillLanguageNl   = searchservice.KeyPhraseExtractionSkillLanguageNl
+	KeyPhraseExtractionSkillLanguageNo   = searchservice.KeyPhraseExtractionSkillLanguageNo
+	KeyPhraseExtractionSkillLanguagePl   = searchservice.KeyPhraseExtractionSkillLanguagePl
+	KeyPhraseExtractionSkillLanguagePtBR = searchservice.KeyPhraseExtractionSkillLanguagePtBR
+	KeyPhraseExtractionSkillLanguagePtPT = searchservice.KeyPhraseExtractionSkillLanguagePtPT
+	KeyPhraseExtractionSkillLanguageRu   = searchservice.KeyPhraseExtractionSkillLanguageRu
+	KeyPhraseExtractionSkillLanguageSv   = searchservice.KeyPhraseExtractionSkillLanguageSv
+)
+
+// LexicalAnalyzerName values re-exported from searchservice.
+const (
+	LexicalAnalyzerNameArLucene                   = searchservice.LexicalAnalyzerNameArLucene
+	LexicalAnalyzerNameArMicrosoft                = searchservice.LexicalAnalyzerNameArMicrosoft
+	LexicalAnalyzerNameBgLucene                   = searchservice.LexicalAnalyzerNameBgLucene
+	LexicalAnalyzerNameBgMicrosoft                = searchservice.LexicalAnalyzerNameBgMicrosoft
+	LexicalAnalyzerNameBnMicrosoft                = searchservice.LexicalAnalyzerNameBnMicrosoft
+	LexicalAnalyzerNameCaLucene                   = searchservice.LexicalAnalyzerNameCaLucene
+	LexicalAnalyzerNameCaMicrosoft                = searchservice.LexicalAnalyzerNameCaMicrosoft
+	LexicalAnalyzerNameCsLucene                   = searchservice.LexicalAnalyzerNameCsLucene
+	LexicalAnalyzerNameCsMicrosoft                = searchservice.LexicalAnalyzerNameCsMicrosoft
+	LexicalAnalyzerNameDaLucene                   = searchservice.LexicalAnalyzerNameDaLucene
+	LexicalAnalyzerNameDaMicrosoft                = searchservice.LexicalAnalyzerNameDaMicrosoft
+	LexicalAnalyzerNameDeLucene                   = searchservice.LexicalAnalyzerNameDeLucene
+	LexicalAnalyzerNameDeMicrosoft                = searchservice.LexicalAnalyzerNameDeMicrosoft
+	LexicalAnalyzerNameElLucene                   = searchservice.LexicalAnalyzerNameElLucene
+	LexicalAnalyzerNameElMicrosoft                = searchservice.LexicalAnalyzerNameElMicrosoft
+	LexicalAnalyzerNameEnLucene                   = searchservice.LexicalAnalyzerNameEnLucene
+	LexicalAnalyzerNameEnMicrosoft                = searchservice.LexicalAnalyzerNameEnMicrosoft
+	LexicalAnalyzerNameEsLucene                   = searchservice.LexicalAnalyzerNameEsLucene
+	LexicalAnalyzerNameEsMicrosoft                = searchservice.LexicalAnalyzerNameEsMicrosoft
+	LexicalAnalyzerNameEtMicrosoft                = searchservice.LexicalAnalyzerNameEtMicrosoft
+	LexicalAnalyzerNameEuLucene                   = searchservice.LexicalAnalyzerNameEuLucene
+	LexicalAnalyzerNameFaLucene                   = searchservice.LexicalAnalyzerNameFaLucene
+	LexicalAnalyzerNameFiLucene                   = searchservice.LexicalAnalyzerNameFiLucene
+	LexicalAnalyzerNameFiMicrosoft                = searchservice.LexicalAnalyzerNameFiMicrosoft
+	LexicalAnalyzerNameFrLucene                   = searchservice.LexicalAnalyzerNameFrLucene
+	LexicalAnalyzerNameFrMicrosoft                = searchservice.LexicalAnalyzerNameFrMicrosoft
+	LexicalAnalyzerNameGaLucene                   = searchservice.LexicalAnalyzerNameGaLucene
+	LexicalAnalyzerNameGlLucene                   = searchservice.LexicalAnalyzerNameGlLucene
+	LexicalAnalyzerNameGuMicrosoft                = searchservice.LexicalAnalyzerNameGuMicrosoft
+	LexicalAnalyzerNameHeMicrosoft                = searchservice.LexicalAnalyzerNameHeMicrosoft
+	LexicalAnalyzerNameHiLucene                   = searchservice.LexicalAnalyzerNameHiLucene
+	LexicalAnalyzerNameHiMicrosoft                = searchservice.LexicalAnalyzerNameHiMicrosoft
+	LexicalAnalyzerNameHrMicrosoft                = searchservice.LexicalAnalyzerNameHrMicrosoft
+	LexicalAnalyzerNameHuLucene                   = searchservice.LexicalAnalyzerNameHuLucene
+	LexicalAnalyzerNameHuMicrosoft                = searchservice.LexicalAnalyzerNameHuMicrosoft
+	LexicalAnalyzerNameHyLucene                   = searchservice.LexicalAnalyzerNameHyLucene
+	LexicalAnalyzerNameIDLucene                   = searchservice.LexicalAnalyzerNameIDLucene
+	LexicalAnalyzerNameIDMicrosoft                = searchservice.LexicalAnalyzerNameIDMicrosoft
+	LexicalAnalyzerNameIsMicrosoft                = searchservice.LexicalAnalyzerNameIsMicrosoft
+	LexicalAnalyzerNameItLucene                   = searchservice.LexicalAnalyzerNameItLucene
+	LexicalAnalyzerNameItMicrosoft                = searchservice.LexicalAnalyzerNameItMicrosoft
+	LexicalAnalyzerNameJaLucene                   = searchservice.LexicalAnalyzerNameJaLucene
+	LexicalAnalyzerNameJaMicrosoft                = searchservice.LexicalAnalyzerNameJaMicrosoft
+	LexicalAnalyzerNameKeyword                    = searchservice.LexicalAnalyzerNameKeyword
+	LexicalAnalyzerNameKnMicrosoft                = searchservice.LexicalAnalyzerNameKnMicrosoft
+	LexicalAnalyzerNameKoLucene                   = searchservice.LexicalAnalyzerNameKoLucene
+	LexicalAnalyzerNameKoMicrosoft                = searchservice.LexicalAnalyzerNameKoMicrosoft
+	LexicalAnalyzerNameLtMicrosoft                = searchservice.LexicalAnalyzerNameLtMicrosoft
+	LexicalAnalyzerNameLvLucene                   = searchservice.LexicalAnalyzerNameLvLucene
+	LexicalAnalyzerNameLvMicrosoft                = searchservice.LexicalAnalyzerNameLvMicrosoft
+	LexicalAnalyzerNameMlMicrosoft                = searchservice.LexicalAnalyzerNameMlMicrosoft
+	LexicalAnalyzerNameMrMicrosoft                = searchservice.LexicalAnalyzerNameMrMicrosoft
+	LexicalAnalyzerNameMsMicrosoft                = searchservice.LexicalAnalyzerNameMsMicrosoft
+	LexicalAnalyzerNameNbMicrosoft                = searchservice.LexicalAnalyzerNameNbMicrosoft
+	LexicalAnalyzerNameNlLucene                   = searchservice.LexicalAnalyzerNameNlLucene
+	LexicalAnalyzerNameNlMicrosoft                = searchservice.LexicalAnalyzerNameNlMicrosoft
+	LexicalAnalyzerNameNoLucene                   = searchservice.LexicalAnalyzerNameNoLucene
+	LexicalAnalyzerNamePaMicrosoft                = searchservice.LexicalAnalyzerNamePaMicrosoft
+	LexicalAnalyzerNamePattern                    = searchservice.LexicalAnalyzerNamePattern
+	LexicalAnalyzerNamePlLucene                   = searchservice.LexicalAnalyzerNamePlLucene
+	LexicalAnalyzerNamePlMicrosoft                = searchservice.LexicalAnalyzerNamePlMicrosoft
+	LexicalAnalyzerNamePtBrLucene                 = searchservice.LexicalAnalyzerNamePtBrLucene
+	LexicalAnalyzerNamePtBrMicrosoft              = searchservice.LexicalAnalyzerNamePtBrMicrosoft
+	LexicalAnalyzerNamePtPtLucene                 = searchservice.LexicalAnalyzerNamePtPtLucene
+	LexicalAnalyzerNamePtPtMicrosoft              = searchservice.LexicalAnalyzerNamePtPtMicrosoft
+	LexicalAnalyzerNameRoLucene                   = searchservice.LexicalAnalyzerNameRoLucene
+	LexicalAnalyzerNameRoMicrosoft                = searchservice.LexicalAnalyzerNameRoMicrosoft
+	LexicalAnalyzerNameRuLucene                   = searchservice.LexicalAnalyzerNameRuLucene
+	LexicalAnalyzerNameRuMicrosoft                = searchservice.LexicalAnalyzerNameRuMicrosoft
+	LexicalAnalyzerNameSimple                     = searchservice.LexicalAnalyzerNameSimple
+	LexicalAnalyzerNameSkMicrosoft                = searchservice.LexicalAnalyzerNameSkMicrosoft
+	LexicalAnalyzerNameSlMicrosoft                = searchservice.LexicalAnalyzerNameSlMicrosoft
+	LexicalAnalyzerNameSrCyrillicMicrosoft        = searchservice.LexicalAnalyzerNameSrCyrillicMicrosoft
+	LexicalAnalyzerNameSrLatinMicrosoft           = searchservice.LexicalAnalyzerNameSrLatinMicrosoft
+	LexicalAnalyzerNameStandardASCIIFoldingLucene = searchservice.LexicalAnalyzerNameStandardASCIIFoldingLucene
+	LexicalAnalyzerNameStandardLucene             = searchservice.LexicalAnalyzerNameStandardLucene
+	LexicalAnalyzerNameStop                       = searchservice.LexicalAnalyzerNameStop
+	LexicalAnalyzerNameSvLucene                   = searchservice.LexicalAnalyzerNameSvLucene
+	LexicalAnalyzerNameSvMicrosoft                = searchservice.LexicalAnalyzerNameSvMicrosoft
+	LexicalAnalyzerNameTaMicrosoft                = searchservice.LexicalAnalyzerNameTaMicrosoft
+	LexicalAnalyzerNameTeMicrosoft                = searchservice.LexicalAnalyzerNameTeMicrosoft
+	LexicalAnalyzerNameThLucene                   = searchservice.LexicalAnalyzerNameThLucene
+	LexicalAnalyzerNameThMicrosoft                = searchservice.LexicalAnalyzerNameThMicrosoft
+	LexicalAnalyzerNameTrLucene                   = searchservice.LexicalAnalyzerNameTrLucene
+	LexicalAnalyzerNameTrMicrosoft                = searchservice.LexicalAnalyzerNameTrMicrosoft
+	LexicalAnalyzerNameUkMicrosoft                = searchservice.LexicalAnalyzerNameUkMicrosoft
+	LexicalAnalyzerNameUrMicrosoft                = searchservice.LexicalAnalyzerNameUrMicrosoft
+	LexicalAnalyzerNameViMicrosoft                = searchservice.LexicalAnalyzerNameViMicrosoft
+	LexicalAnalyzerNameWhitespace                 = searchservice.LexicalAnalyzerNameWhitespace
+	LexicalAnalyzerNameZhHansLucene               = searchservice.LexicalAnalyzerNameZhHansLucene
+	LexicalAnalyzerNameZhHansMicrosoft            = searchservice.LexicalAnalyzerNameZhHansMicrosoft
+	LexicalAnalyzerNameZhHantLucene               = searchservice.LexicalAnalyzerNameZhHantLucene
+	LexicalAnalyzerNameZhHantMicrosoft            = searchservice.LexicalAnalyzerNameZhHantMicrosoft
+)
+
+// LexicalNormalizerName values re-exported from searchservice.
+const (
+	LexicalNormalizerNameASCIIFolding = searchservice.LexicalNormalizerNameASCIIFolding
+	LexicalNormalizerNameElision      = searchservice.LexicalNormalizerNameElision
+	LexicalNormalizerNameLowercase    = searchservice.LexicalNormalizerNameLowercase
+	LexicalNormalizerNameStandard     = searchservice.LexicalNormalizerNameStandard
+	LexicalNormalizerNameUppercase    = searchservice.LexicalNormalizerNameUppercase
+)
+
+// LexicalTokenizerName values re-exported from searchservice.
+const (
+	LexicalTokenizerNameClassic                            = searchservice.LexicalTokenizerNameClassic
+	LexicalTokenizerNameEdgeNGram                          = searchservice.LexicalTokenizerNameEdgeNGram
+	LexicalTokenizerNameKeyword                            = searchservice.LexicalTokenizerNameKeyword
+	LexicalTokenizerNameLetter                             = searchservice.LexicalTokenizerNameLetter
+	LexicalTokenizerNameLowercase                          = searchservice.LexicalTokenizerNameLowercase
+	LexicalTokenizerNameMicrosoftLanguageStemmingTokenizer = searchservice.LexicalTokenizerNameMicrosoftLanguageStemmingTokenizer
+	LexicalTokenizerNameMicrosoftLanguageTokenizer         = searchservice.LexicalTokenizerNameMicrosoftLanguageTokenizer
+	LexicalTokenizerNameNGram                              = searchservice.LexicalTokenizerNameNGram
+	LexicalTokenizerNamePathHierarchy                      = searchservice.LexicalTokenizerNamePathHierarchy
+	LexicalTokenizerNamePattern                            = searchservice.LexicalTokenizerNamePattern
+	LexicalTokenizerNameStandard                           = searchservice.LexicalTokenizerNameStandard
+	LexicalTokenizerNameUaxURLEmail                        = searchservice.LexicalTokenizerNameUaxURLEmail
+	LexicalTokenizerNameWhitespace                         = searchservice.LexicalTokenizerNameWhitespace
+)
+
+// LineEnding values re-exported from searchservice.
+const (
+	LineEndingCarriageReturn         = searchservice.LineEndingCarriageReturn
+	LineEndingCarriageReturnLineFeed = searchservice.LineEndingCarriageReturnLineFeed
+	LineEndingLineFeed               = searchservice.LineEndingLineFeed
+	LineEndingSpace                  = searchservice.LineEndingSpace
+)
+
+// MicrosoftStemmingTokenizerLanguage values re-exported from searchservice.
+const (
+	MicrosoftStemmingTokenizerLanguageArabic              = searchservice.MicrosoftStemmingTokenizerLanguageArabic
+	MicrosoftStemmingTokenizerLanguageBangla              = searchservice.MicrosoftStemmingTokenizerLanguageBangla
+	MicrosoftStemmingTokenizerLanguageBulgarian           = searchservice.MicrosoftStemmingTokenizerLanguageBulgarian
+	MicrosoftStemmingTokenizerLanguageCatalan             = searchservice.MicrosoftStemmingTokenizerLanguageCatalan
+	MicrosoftStemmingTokenizerLanguageCroatian            = searchservice.MicrosoftStemmingTokenizerLanguageCroatian
+	MicrosoftStemmingTokenizerLanguageCzech               = searchservice.MicrosoftStemmingTokenizerLanguageCzech
+	MicrosoftStemmingTokenizerLanguageDanish              = searchservice.MicrosoftStemmingTokenizerLanguageDanish
+	MicrosoftStemmingTokenizerLanguageDutch               = searchservice.MicrosoftStemmingTokenizerLanguageDutch
+	MicrosoftStemmingTokenizerLanguageEnglish             = searchservice.MicrosoftStemmingTokenizerLanguageEnglish
+	MicrosoftStemmingTokenizerLanguageEstonian            = searchservice.MicrosoftStemmingTokenizerLanguageEstonian
+	MicrosoftStemmingTokenizerLanguageFinnish             = searchservice.MicrosoftStemmingTokenizerLanguageFinnish
+	MicrosoftStemmingTokenizerLanguageFrench              = searchservice.MicrosoftStemmingTokenizerLanguageFrench
+	MicrosoftStemmingTokenizerLanguageGerman              = searchservice.MicrosoftStemmingTokenizerLanguageGerman
+	MicrosoftStemmingTokenizerLanguageGreek               = searchservice.MicrosoftStemmingTokenizerLanguageGreek
+	MicrosoftStemmingTokenizerLanguageGujarati            = searchservice.MicrosoftStemmingTokenizerLanguageGujarati
+	MicrosoftStemmingTokenizerLanguageHebrew              = searchservice.MicrosoftStemmingTokenizerLanguageHebrew
+	MicrosoftStemmingTokenizerLanguageHindi               = searchservice.MicrosoftStemmingTokenizerLanguageHindi
+	MicrosoftStemmingTokenizerLanguageHungarian           = searchservice.MicrosoftStemmingTokenizerLanguageHungarian
+	MicrosoftStemmingTokenizerLanguageIcelandic           = searchservice.MicrosoftStemmingTokenizerLanguageIcelandic
+	MicrosoftStemmingTokenizerLanguageIndonesian          = searchservice.MicrosoftStemmingTokenizerLanguageIndonesian
+	MicrosoftStemmingTokenizerLanguageItalian             = searchservice.MicrosoftStemmingTokenizerLanguageItalian
+	MicrosoftStemmingTokenizerLanguageKannada             = searchservice.MicrosoftStemmingTokenizerLanguageKannada
+	MicrosoftStemmingTokenizerLanguageLatvian             = searchservice.MicrosoftStemmingTokenizerLanguageLatvian
+	MicrosoftStemmingTokenizerLanguageLithuanian          = searchservice.MicrosoftStemmingTokenizerLanguageLithuanian
+	MicrosoftStemmingTokenizerLanguageMalay               = searchservice.MicrosoftStemmingTokenizerLanguageMalay
+	MicrosoftStemmingTokenizerLanguageMalayalam           = searchservice.MicrosoftStemmingTokenizerLanguageMalayalam
+	MicrosoftStemmingTokenizerLanguageMarathi             = searchservice.MicrosoftStemmingTokenizerLanguageMarathi
+	MicrosoftStemmingTokenizerLanguageNorwegianBokmaal    = searchservice.MicrosoftStemmingTokenizerLanguageNorwegianBokmaal
+	MicrosoftStemmingTokenizerLanguagePolish              = searchservice.MicrosoftStemmingTokenizerLanguagePolish
+	MicrosoftStemmingTokenizerLanguagePortuguese          = searchservice.MicrosoftStemmingTokenizerLanguagePortuguese
+	MicrosoftStemmingTokenizerLanguagePortugueseBrazilian = searchservice.MicrosoftStemmingTokenizerLanguagePortugueseBrazilian
+	MicrosoftStemmingTokenizerLanguagePunjabi             = searchservice.MicrosoftStemmingTokenizerLanguagePunjabi
+	MicrosoftStemmingTokenizerLanguageRomanian            = searchservice.MicrosoftStemmingTokenizerLanguageRomanian
+	MicrosoftStemmingTokenizerLanguageRussian             = searchservice.MicrosoftStemmingTokenizerLanguageRussian
+	MicrosoftStemmingTokenizerLanguageSerbianCyrillic     = searchservice.MicrosoftStemmingTokenizerLanguageSerbianCyrillic
+	MicrosoftStemmingTokenizerLanguageSerbianLatin        = searchservice.MicrosoftStemmingTokenizerLanguageSerbianLatin
+	MicrosoftStemmingTokenizerLanguageSlovak              = searchservice.MicrosoftStemmingTokenizerLanguageSlovak
+	MicrosoftStemmingTokenizerLanguageSlovenian           = searchservice.MicrosoftStemmingTokenizerLanguageSlovenian
+	MicrosoftStemmingTokenizerLanguageSpanish             = searchservice.MicrosoftStemmingTokenizerLanguageSpanish
+	MicrosoftStemmingTokenizerLanguageSwedish             = searchservice.MicrosoftStemmingTokenizerLanguageSwedish
+	MicrosoftStemmingTokenizerLanguageTamil               = searchservice.MicrosoftStemmingTokenizerLanguageTamil
+	MicrosoftStemmingTokenizerLanguageTelugu              = searchservice.MicrosoftStemmingTokenizerLanguageTelugu
+	MicrosoftStemmingTokenizerLanguageTurkish             = searchservice.MicrosoftStemmingTokenizerLanguageTurkish
+	MicrosoftStemmingTokenizerLanguageUkrainian           = searchservice.MicrosoftStemmingTokenizerLanguageUkrainian
+	MicrosoftStemmingTokenizerLanguageUrdu                = searchservice.MicrosoftStemmingTokenizerLanguageUrdu
+)
+
+// MicrosoftTokenizerLanguage values re-exported from searchservice.
+const (
+	MicrosoftTokenizerLanguageBangla              = searchservice.MicrosoftTokenizerLanguageBangla
+	MicrosoftTokenizerLanguageBulgarian           = searchservice.MicrosoftTokenizerLanguageBulgarian
+	MicrosoftTokenizerLanguageCatalan             = searchservice.MicrosoftTokenizerLanguageCatalan
+	MicrosoftTokenizerLanguageChineseSimplified   = searchservice.MicrosoftTokenizerLanguageChineseSimplified
+	MicrosoftTokenizerLanguageChineseTraditional  = searchservice.MicrosoftTokenizerLanguageChineseTraditional
+	MicrosoftTokenizerLanguageCroatian            = searchservice.MicrosoftTokenizerLanguageCroatian
+	MicrosoftTokenizerLanguageCzech               = searchservice.MicrosoftTokenizerLanguageCzech
+	MicrosoftTokenizerLanguageDanish              = searchservice.MicrosoftTokenizerLanguageDanish
+	MicrosoftTokenizerLanguageDutch               = searchservice.MicrosoftTokenizerLanguageDutch
+	MicrosoftTokenizerLanguageEnglish             = searchservice.MicrosoftTokenizerLanguageEnglish
+	MicrosoftTokenizerLanguageFrench              = searchservice.MicrosoftTokenizerLanguageFrench
+	MicrosoftTokenizerLanguageGerman              = searchservice.MicrosoftTokenizerLanguageGerman
+	MicrosoftTokenizerLanguageGreek               = searchservice.MicrosoftTokenizerLanguageGreek
+	MicrosoftTokenizerLanguageGujarati            = searchservice.MicrosoftTokenizerLanguageGujarati
+	MicrosoftTokenizerLanguageHindi               = searchservice.MicrosoftTokenizerLanguageHindi
+	MicrosoftTokenizerLanguageIcelandic           = searchservice.MicrosoftTokenizerLanguageIcelandic
+	MicrosoftTokenizerLanguageIndonesian          = searchservice.MicrosoftTokenizerLanguageIndonesian
+	MicrosoftTokenizerLanguageItalian             = searchservice.MicrosoftTokenizerLanguageItalian
+	MicrosoftTokenizerLanguageJapanese            = searchservice.MicrosoftTokenizerLanguageJapanese
+	MicrosoftTokenizerLanguageKannada             = searchservice.MicrosoftTokenizerLanguageKannada
+	MicrosoftTokenizerLanguageKorean              = searchservice.MicrosoftTokenizerLanguageKorean
+	MicrosoftTokenizerLanguageMalay               = searchservice.MicrosoftTokenizerLanguageMalay
+	MicrosoftTokenizerLanguageMalayalam           = searchservice.MicrosoftTokenizerLanguageMalayalam
+	MicrosoftTokenizerLanguageMarathi             = searchservice.MicrosoftTokenizerLanguageMarathi
+	MicrosoftTokenizerLanguageNorwegianBokmaal    = searchservice.MicrosoftTokenizerLanguageNorwegianBokmaal
+	MicrosoftTokenizerLanguagePolish              = searchservice.MicrosoftTokenizerLanguagePolish
+	MicrosoftTokenizerLanguagePortuguese          = searchservice.MicrosoftTokenizerLanguagePortuguese
+	MicrosoftTokenizerLanguagePortugueseBrazilian = searchservice.MicrosoftTokenizerLanguagePortugueseBrazilian
+	MicrosoftTokenizerLanguagePunjabi             = searchservice.MicrosoftTokenizerLanguagePunjabi
+	MicrosoftTokenizerLanguageRomanian            = searchservice.MicrosoftTokenizerLanguageRomanian
+	MicrosoftTokenizerLanguageRussian             = searchservice.MicrosoftTokenizerLanguageRussian
+	MicrosoftTokenizerLanguageSerbianCyrillic     = searchservice.MicrosoftTokenizerLanguageSerbianCyrillic
+	MicrosoftTokenizerLanguageSerbianLatin        = searchservice.MicrosoftTokenizerLanguageSerbianLatin
+	MicrosoftTokenizerLanguageSlovenian           = searchservice.MicrosoftTokenizerLanguageSlovenian
+	MicrosoftTokenizerLanguageSpanish             = searchservice.MicrosoftTokenizerLanguageSpanish
+	MicrosoftTokenizerLanguageSwedish             = searchservice.MicrosoftTokenizerLanguageSwedish
+	MicrosoftTokenizerLanguageTamil               = searchservice.MicrosoftTokenizerLanguageTamil
+	MicrosoftTokenizerLanguageTelugu              = searchservice.MicrosoftTokenizerLanguageTelugu
+	MicrosoftTokenizerLanguageThai                = searchservice.MicrosoftTokenizerLanguageThai
+	MicrosoftTokenizerLanguageUkrainian           = searchservice.MicrosoftTokenizerLanguageUkrainian
+	MicrosoftTokenizerLanguageUrdu                = searchservice.MicrosoftTokenizerLanguageUrdu
+	MicrosoftTokenizerLanguageVietnamese          = searchservice.MicrosoftTokenizerLanguageVietnamese
+)
+
+// OcrSkillLanguage values re-exported from searchservice.
+const (
+	OcrSkillLanguageAf      = searchservice.OcrSkillLanguageAf
+	OcrSkillLanguageAnp     = searchservice.OcrSkillLanguageAnp
+	OcrSkillLanguageAr      = searchservice.OcrSkillLanguageAr
+	OcrSkillLanguageAst     = searchservice.OcrSkillLanguageAst
+	OcrSkillLanguageAwa     = searchservice.OcrSkillLanguageAwa
+	OcrSkillLanguageAz      = searchservice.OcrSkillLanguageAz
+	OcrSkillLanguageBe      = searchservice.OcrSkillLanguageBe
+	OcrSkillLanguageBeCyrl  = searchservice.OcrSkillLanguageBeCyrl
+	OcrSkillLanguageBeLatn  = searchservice.OcrSkillLanguageBeLatn
+	OcrSkillLanguageBfy     = searchservice.OcrSkillLanguageBfy
+	OcrSkillLanguageBfz     = searchservice.OcrSkillLanguageBfz
+	OcrSkillLanguageBg      = searchservice.OcrSkillLanguageBg
+	OcrSkillLanguageBgc     = searchservice.OcrSkillLanguageBgc
+	OcrSkillLanguageBho     = searchservice.OcrSkillLanguageBho
+	OcrSkillLanguageBi      = searchservice.OcrSkillLanguageBi
+	OcrSkillLanguageBns     = searchservice.OcrSkillLanguageBns
+	OcrSkillLanguageBr      = searchservice.OcrSkillLanguageBr
+	OcrSkillLanguageBra     = searchservice.OcrSkillLanguageBra
+	OcrSkillLanguageBrx     = searchservice.OcrSkillLanguageBrx
+	OcrSkillLanguageBs      = searchservice.OcrSkillLanguageBs
+	OcrSkillLanguageBua     = searchservice.OcrSkillLanguageBua
+	OcrSkillLanguageCa      = searchservice.OcrSkillLanguageCa
+	OcrSkillLanguageCeb     = searchservice.OcrSkillLanguageCeb
+	OcrSkillLanguageCh      = searchservice.OcrSkillLanguageCh
+	OcrSkillLanguageCnrCyrl = searchservice.OcrSkillLanguageCnrCyrl
+	OcrSkillLanguageCnrLatn = searchservice.OcrSkillLanguageCnrLatn
+	OcrSkillLanguageCo      = searchservice.OcrSkillLanguageCo
+	OcrSkillLanguageCrh     = searchservice.OcrSkillLanguageCrh
+	OcrSkillLanguageCs      = searchservice.OcrSkillLanguageCs
+	OcrSkillLanguageCsb     = searchservice.OcrSkillLanguageCsb
+	OcrSkillLanguageCy      = searchservice.OcrSkillLanguageCy
+	OcrSkillLanguageDa      = searchservice.OcrSkillLanguageDa
+	OcrSkillLanguageDe      = searchservice.OcrSkillLanguageDe
+	OcrSkillLanguageDhi     = searchservice.OcrSkillLanguageDhi
+	OcrSkillLanguageDoi     = searchservice.OcrSkillLanguageDoi
+	OcrSkillLanguageDsb     = searchservice.OcrSkillLanguageDsb
+	OcrSkillLanguageEl      = searchservice.OcrSkillLanguageEl
+	OcrSkillLanguageEn      = searchservice.OcrSkillLanguageEn
+	OcrSkillLanguageEs      = searchservice.OcrSkillLanguageEs
+	OcrSkillLanguageEt      = searchservice.OcrSkillLanguageEt
+	OcrSkillLanguageEu      = searchservice.OcrSkillLanguageEu
+	OcrSkillLanguageFa      = searchservice.OcrSkillLanguageFa
+	OcrSkillLanguageFi      = searchservice.OcrSkillLanguageFi
+	OcrSkillLanguageFil     = searchservice.OcrSkillLanguageFil
+	OcrSkillLanguageFj      = searchservice.OcrSkillLanguageFj
+	OcrSkillLanguageFo      = searchservice.OcrSkillLanguageFo
+	OcrSkillLanguageFr      = searchservice.OcrSkillLanguageFr
+	OcrSkillLanguageFur     = searchservice.OcrSkillLanguageFur
+	OcrSkillLanguageFy      = searchservice.OcrSkillLanguageFy
+	OcrSkillLanguageGa      = searchservice.OcrSkillLanguageGa
+	OcrSkillLanguageGag     = searchservice.OcrSkillLanguageGag
+	OcrSkillLanguageGd      = searchservice.OcrSkillLanguageGd
+	OcrSkillLanguageGil     = searchservice.OcrSkillLanguageGil
+	OcrSkillLanguageGl      = searchservice.OcrSkillLanguageGl
+	OcrSkillLanguageGon     = searchservice.OcrSkillLanguageGon
+	OcrSkillLanguageGv      = searchservice.OcrSkillLanguageGv
+	OcrSkillLanguageGvr     = searchservice.OcrSkillLanguageGvr
+	OcrSkillLanguageHaw     = searchservice.OcrSkillLanguageHaw
+	OcrSkillLanguageHi      = searchservice.OcrSkillLanguageHi
+	OcrSkillLanguageHlb     = searchservice.OcrSkillLanguageHlb
+	OcrSkillLanguageHne     = searchservice.OcrSkillLanguageHne
+	OcrSkillLanguageHni     = searchservice.OcrSkillLanguageHni
+	OcrSkillLanguageHoc     = searchservice.OcrSkillLanguageHoc
+	OcrSkillLanguageHr      = searchservice.OcrSkillLanguageHr
+	OcrSkillLanguageHsb     = searchservice.OcrSkillLanguageHsb
+	OcrSkillLanguageHt      = searchservice.OcrSkillLanguageHt
+	OcrSkillLanguageHu      = searchservice.OcrSkillLanguageHu
+	OcrSkillLanguageID      = searchservice.OcrSkillLanguageID
+	OcrSkillLanguageIa      = searchservice.OcrSkillLanguageIa
+	OcrSkillLanguageIs      = searchservice.OcrSkillLanguageIs
+	OcrSkillLanguageIt      = searchservice.OcrSkillLanguageIt
+	OcrSkillLanguageIu      = searchservice.OcrSkillLanguageIu
+	OcrSkillLanguageJa      = searchservice.OcrSkillLanguageJa
+	OcrSkillLanguageJns     = searchservice.OcrSkillLanguageJns
+	OcrSkillLanguageJv      = searchservice.OcrSkillLanguageJv
+	OcrSkillLanguageKaa     = searchservice.OcrSkillLanguageKaa
+	OcrSkillLanguageKaaCyrl = searchservice.OcrSkillLanguageKaaCyrl
+	OcrSkillLanguageKac     = searchservice.OcrSkillLanguageKac
+	OcrSkillLanguageKea     = searchservice.OcrSkillLanguageKea
+	OcrSkillLanguageKfq     = searchservice.OcrSkillLanguageKfq
+	OcrSkillLanguageKha     = searchservice.OcrSkillLanguageKha
+	OcrSkillLanguageKkCyrl  = searchservice.OcrSkillLanguageKkCyrl
+	OcrSkillLanguageKkLatn  = searchservice.OcrSkillLanguageKkLatn
+	OcrSkillLanguageKl      = searchservice.OcrSkillLanguageKl
+	OcrSkillLanguageKlr     = searchservice.OcrSkillLanguageKlr
+	OcrSkillLanguageKmj     = searchservice.OcrSkillLanguageKmj
+	OcrSkillLanguageKo      = searchservice.OcrSkillLanguageKo
+	OcrSkillLanguageKos     = searchservice.OcrSkillLanguageKos
+	OcrSkillLanguageKpy     = searchservice.OcrSkillLanguageKpy
+	OcrSkillLanguageKrc     = searchservice.OcrSkillLanguageKrc
+	OcrSkillLanguageKru     = searchservice.OcrSkillLanguageKru
+	OcrSkillLanguageKsh     = searchservice.OcrSkillLanguageKsh
+	OcrSkillLanguageKuArab  = searchservice.OcrSkillLanguageKuArab
+	OcrSkillLanguageKuLatn  = searchservice.OcrSkillLanguageKuLatn
+	OcrSkillLanguageKum     = searchservice.OcrSkillLanguageKum
+	OcrSkillLanguageKw      = searchservice.OcrSkillLanguageKw
+	OcrSkillLanguageKy      = searchservice.OcrSkillLanguageKy
+	OcrSkillLanguageLa      = searchservice.OcrSkillLanguageLa
+	OcrSkillLanguageLb      = searchservice.OcrSkillLanguageLb
+	OcrSkillLanguageLkt     = searchservice.OcrSkillLanguageLkt
+	OcrSkillLanguageLt      = searchservice.OcrSkillLanguageLt
+	OcrSkillLanguageMi      = searchservice.OcrSkillLanguageMi
+	OcrSkillLanguageMn      = searchservice.OcrSkillLanguageMn
+	OcrSkillLanguageMr      = searchservice.OcrSkillLanguageMr
+	OcrSkillLanguageMs      = searchservice.OcrSkillLanguageMs
+	OcrSkillLanguageMt      = searchservice.OcrSkillLanguageMt
+	OcrSkillLanguageMww     = searchservice.OcrSkillLanguageMww
+	OcrSkillLanguageMyv     = searchservice.OcrSkillLanguageMyv
+	OcrSkillLanguageNap     = searchservice.OcrSkillLanguageNap
+	OcrSkillLanguageNb      = searchservice.OcrSkillLanguageNb
+	OcrSkillLanguageNe      = searchservice.OcrSkillLanguageNe
+	OcrSkillLanguageNiu     = searchservice.OcrSkillLanguageNiu
+	OcrSkillLanguageNl      = searchservice.OcrSkillLanguageNl
+	OcrSkillLanguageNo      = searchservice.OcrSkillLanguageNo
+	OcrSkillLanguageNog     = searchservice.OcrSkillLanguageNog
+	OcrSkillLanguageOc      = searchservice.OcrSkillLanguageOc
+	OcrSkillLanguageOs      = searchservice.OcrSkillLanguageOs
+	OcrSkillLanguagePa      = searchservice.OcrSkillLanguagePa
+	OcrSkillLanguagePl      = searchservice.OcrSkillLanguagePl
+	OcrSkillLanguagePrs     = searchservice.OcrSkillLanguagePrs
+	OcrSkillLanguagePs      = searchservice.OcrSkillLanguagePs
+	OcrSkillLanguagePt      = searchservice.OcrSkillLanguagePt
+	OcrSkillLanguageQuc     = searchservice.OcrSkillLanguageQuc
+	OcrSkillLanguageRab     = searchservice.OcrSkillLanguageRab
+	OcrSkillLanguageRm      = searchservice.OcrSkillLanguageRm
+	OcrSkillLanguageRo      = searchservice.OcrSkillLanguageRo
+	OcrSkillLanguageRu      = searchservice.OcrSkillLanguageRu
+	OcrSkillLanguageSa      = searchservice.OcrSkillLanguageSa
+	OcrSkillLanguageSat     = searchservice.OcrSkillLanguageSat
+	OcrSkillLanguageSck     = searchservice.OcrSkillLanguageSck
+	OcrSkillLanguageSco     = searchservice.OcrSkillLanguageSco
+	OcrSkillLanguageSk      = searchservice.OcrSkillLanguageSk
+	OcrSkillLanguageSl      = searchservice.OcrSkillLanguageSl
+	OcrSkillLanguageSm      = searchservice.OcrSkillLanguageSm
+	OcrSkillLanguageSma     = searchservice.OcrSkillLanguageSma
+	OcrSkillLanguageSme     = searchservice.OcrSkillLanguageSme
+	OcrSkillLanguageSmj     = searchservice.OcrSkillLanguageSmj
+	OcrSkillLanguageSmn     = searchservice.OcrSkillLanguageSmn
+	OcrSkillLanguageSms     = searchservice.OcrSkillLanguageSms
+	OcrSkillLanguageSo      = searchservice.OcrSkillLanguageSo
+	OcrSkillLanguageSq      = searchservice.OcrSkillLanguageSq
+	OcrSkillLanguageSr      = searchservice.OcrSkillLanguageSr
+	OcrSkillLanguageSrCyrl  = searchservice.OcrSkillLanguageSrCyrl
+	OcrSkillLanguageSrLatn  = searchservice.OcrSkillLanguageSrLatn
+	OcrSkillLanguageSrx     = searchservice.OcrSkillLanguageSrx
+	OcrSkillLanguageSv      = searchservice.OcrSkillLanguageSv
+	OcrSkillLanguageSw      = searchservice.OcrSkillLanguageSw
+	OcrSkillLanguageTet     = searchservice.OcrSkillLanguageTet
+	OcrSkillLanguageTg      = searchservice.OcrSkillLanguageTg
+	OcrSkillLanguageThf     = searchservice.OcrSkillLanguageThf
+	OcrSkillLanguageTk      = searchservice.OcrSkillLanguageTk
+	OcrSkillLanguageTo      = searchservice.OcrSkillLanguageTo
+	OcrSkillLanguageTr      = searchservice.OcrSkillLanguageTr
+	OcrSkillLanguageTt      = searchservice.OcrSkillLanguageTt
+	OcrSkillLanguageTyv     = searchservice.OcrSkillLanguageTyv
+	OcrSkillLanguageUg      = searchservice.OcrSkillLanguageUg
+	OcrSkillLanguageUnk     = searchservice.OcrSkillLanguageUnk
+	OcrSkillLanguageUr      = searchservice.OcrSkillLanguageUr
+	OcrSkillLanguageUz      = searchservice.OcrSkillLanguageUz
+	OcrSkillLanguageUzArab  = searchservice.OcrSkillLanguageUzArab
+	OcrSkillLanguageUzCyrl  = searchservice.OcrSkillLanguageUzCyrl
+	OcrSkillLanguageVo      = searchservice.OcrSkillLanguageVo
+	OcrSkillLanguageWae     = searchservice.OcrSkillLanguageWae
+	OcrSkillLanguageXnr     = searchservice.OcrSkillLanguageXnr
+	OcrSkillLanguageXsr     = searchservice.OcrSkillLanguageXsr
+	OcrSkillLanguageYua     = searchservice.OcrSkillLanguageYua
+	OcrSkillLanguageZa      = searchservice.OcrSkillLanguageZa
+	OcrSkillLanguageZhHans  = searchservice.OcrSkillLanguageZhHans
+	OcrSkillLanguageZhHant  = searchservice.OcrSkillLanguageZhHant
+	OcrSkillLanguageZu      = searchservice.OcrSkillLanguageZu
+)
+
+// PIIDetectionSkillMaskingMode values re-exported from searchservice.
+const (
+	PIIDetectionSkillMaskingModeNone    = searchservice.PIIDetectionSkillMaskingModeNone
+	PIIDetectionSkillMaskingModeReplace = searchservice.PIIDetectionSkillMaskingModeReplace
+)
+
+// PhoneticEncoder values re-exported from searchservice.
+const (
+	PhoneticEncoderBeiderMorse     = searchservice.PhoneticEncoderBeiderMorse
+	PhoneticEncoderCaverphone1     = searchservice.PhoneticEncoderCaverphone1
+	PhoneticEncoderCaverphone2     = searchservice.PhoneticEncoderCaverphone2
+	PhoneticEncoderCologne         = searchservice.PhoneticEncoderCologne
+	PhoneticEncoderDoubleMetaphone = searchservice.PhoneticEncoderDoubleMetaphone
+	PhoneticEncoderHaasePhonetik   = searchservice.PhoneticEncoderHaasePhonetik
+	PhoneticEncoderKoelnerPhonetik = searchservice.PhoneticEncoderKoelnerPhonetik
+	PhoneticEncoderMetaphone       = searchservice.PhoneticEncoderMetaphone
+	PhoneticEncoderNysiis          = searchservice.PhoneticEncoderNysiis
+	PhoneticEncoderRefinedSoundex  = searchservice.PhoneticEncoderRefinedSoundex
+	PhoneticEncoderSoundex         = searchservice.PhoneticEncoderSoundex
+)
+
+// RankingOrder values re-exported from searchservice.
+const (
+	RankingOrderBoostedRerankerScore = searchservice.RankingOrderBoostedRerankerScore
+	RankingOrderReRankerScore        = searchservice.RankingOrderReRankerScore
+)
+
+// RegexFlags values re-exported from searchservice.
+const (
+	RegexFlagsCanonEq         = searchservice.RegexFlagsCanonEq
+	RegexFlagsCaseInsensitive = searchservice.RegexFlagsCaseInsensitive
+	RegexFlagsComments        = searchservice.RegexFlagsComments
+	RegexFlagsDotAll          = searchservice.RegexFlagsDotAll
+	RegexFlagsLiteral         = searchservice.RegexFlagsLiteral
+	RegexFlagsMultiline       = searchservice.RegexFlagsMultiline
+	RegexFlagsUnicodeCase     = searchservice.RegexFlagsUnicodeCase
+	RegexFlagsUnixLines       = searchservice.RegexFlagsUnixLines
+)
+
+// ScoringFunctionAggregation values re-exported from searchservice.
+const (
+	ScoringFunctionAggregationAverage       = searchservice.ScoringFunctionAggregationAverage
+	ScoringFunctionAggregationFirstMatching = searchservice.ScoringFunctionAggregationFirstMatching
+	ScoringFunctionAggregationMaximum       = searchservice.ScoringFunctionAggregationMaximum
+	ScoringFunctionAggregationMinimum       = searchservice.ScoringFunctionAggregationMinimum
+	ScoringFunctionAggregationSum           = searchservice.ScoringFunctionAggregationSum
+)
+
+// ScoringFunctionInterpolation values re-exported from searchservice.
+const (
+	ScoringFunctionInterpolationConstant    = searchservice.ScoringFunctionInterpolationConstant
+	ScoringFunctionInterpolationLinear      = searchservice.ScoringFunctionInterpolationLinear
+	ScoringFunctionInterpolationLogarithmic = searchservice.ScoringFunctionInterpolationLogarithmic
+	ScoringFunctionInterpolationQuadratic   = searchservice.ScoringFunctionInterpolationQuadratic
+)
+
+// SearchFieldDataType values re-exported from searchservice.
+const (
+	SearchFieldDataTypeBoolean        = searchservice.SearchFieldDataTypeBoolean
+	SearchFieldDataTypeByte           = searchservice.SearchFieldDataTypeByte
+	SearchFieldDataTypeComplex        = searchservice.SearchFieldDataTypeComplex
+	SearchFieldDataTypeDateTimeOffset = searchservice.SearchFieldDataTypeDateTimeOffset
+	SearchFieldDataTypeDouble         = searchservice.SearchFieldDataTypeDouble
+	SearchFieldDataTypeGeographyPoint = searchservice.SearchFieldDataTypeGeographyPoint
+	SearchFieldDataTypeHalf           = searchservice.SearchFieldDataTypeHalf
+	SearchFieldDataTypeInt16          = searchservice.SearchFieldDataTypeInt16
+	SearchFieldDataTypeInt32          = searchservice.SearchFieldDataTypeInt32
+	SearchFieldDataTypeInt64          = searchservice.SearchFieldDataTypeInt64
+	SearchFieldDataTypeSByte          = searchservice.SearchFieldDataTypeSByte
+	SearchFieldDataTypeSingle         = searchservice.SearchFieldDataTypeSingle
+	SearchFieldDataTypeString         = searchservice.SearchFieldDataTypeString
+)
+
+// SearchIndexerDataSourceType values re-exported from searchservice.
+const (
+	SearchIndexerDataSourceTypeAdlsGen2   = searchservice.SearchIndexerDataSourceTypeAdlsGen2
+	SearchIndexerDataSourceTypeAzureBlob  = searchservice.SearchIndexerDataSourceTypeAzureBlob
+	SearchIndexerDataSourceTypeAzureSQL   = searchservice.SearchIndexerDataSourceTypeAzureSQL
+	SearchIndexerDataSourceTypeAzureTable = searchservice.SearchIndexerDataSourceTypeAzureTable
+	SearchIndexerDataSourceTypeCosmosDb   = searchservice.SearchIndexerDataSourceTypeCosmosDb
+	SearchIndexerDataSourceTypeMySQL      = searchservice.SearchIndexerDataSourceTypeMySQL
+	SearchIndexerDataSourceTypeOneLake    = searchservice.SearchIndexerDataSourceTypeOneLake
+)
+
+// SentimentSkillLanguage values re-exported from searchservice.
+const (
+	SentimentSkillLanguageDa   = searchservice.SentimentSkillLanguageDa
+	SentimentSkillLanguageDe   = searchservice.SentimentSkillLanguageDe
+	SentimentSkillLanguageEl   = searchservice.SentimentSkillLanguageEl
+	SentimentSkillLanguageEn   = searchservice.SentimentSkillLanguageEn
+	SentimentSkillLanguageEs   = searchservice.SentimentSkillLanguageEs
+	SentimentSkillLanguageFi   = searchservice.SentimentSkillLanguageFi
+	SentimentSkillLanguageFr   = searchservice.SentimentSkillLanguageFr
+	SentimentSkillLanguageIt   = searchservice.SentimentSkillLanguageIt
+	SentimentSkillLanguageNl   = searchservice.SentimentSkillLanguageNl
+	SentimentSkillLanguageNo   = searchservice.SentimentSkillLanguageNo
+	SentimentSkillLanguagePl   = searchservice.SentimentSkillLanguagePl
+	SentimentSkillLanguagePtPT = searchservice.SentimentSkillLanguagePtPT
+	SentimentSkillLanguageRu   = searchservice.SentimentSkillLanguageRu
+	SentimentSkillLanguageSv   = searchservice.SentimentSkillLanguageSv
+	SentimentSkillLanguageTr   = searchservice.SentimentSkillLanguageTr
+)
+
+// SnowballTokenFilterLanguage values re-exported from searchservice.
+const (
+	SnowballTokenFilterLanguageArmenian   = searchservice.SnowballTokenFilterLanguageArmenian
+	SnowballTokenFilterLanguageBasque     = searchservice.SnowballTokenFilterLanguageBasque
+	SnowballTokenFilterLanguageCatalan    = searchservice.SnowballTokenFilterLanguageCatalan
+	SnowballTokenFilterLanguageDanish     = searchservice.SnowballTokenFilterLanguageDanish
+	SnowballTokenFilterLanguageDutch      = searchservice.SnowballTokenFilterLanguageDutch
+	SnowballTokenFilterLanguageEnglish    = searchservice.SnowballTokenFilterLanguageEnglish
+	SnowballTokenFilterLanguageFinnish    = searchservice.SnowballTokenFilterLanguageFinnish
+	SnowballTokenFilterLanguageFrench     = searchservice.SnowballTokenFilterLanguageFrench
+	SnowballTokenFilterLanguageGerman     = searchservice.SnowballTokenFilterLanguageGerman
+	SnowballTokenFilterLanguageGerman2    = searchservice.SnowballTokenFilterLanguageGerman2
+	SnowballTokenFilterLanguageHungarian  = searchservice.SnowballTokenFilterLanguageHungarian
+	SnowballTokenFilterLanguageItalian    = searchservice.SnowballTokenFilterLanguageItalian
+	SnowballTokenFilterLanguageKp         = searchservice.SnowballTokenFilterLanguageKp
+	SnowballTokenFilterLanguageLovins     = searchservice.SnowballTokenFilterLanguageLovins
+	SnowballTokenFilterLanguageNorwegian  = searchservice.SnowballTokenFilterLanguageNorwegian
+	SnowballTokenFilterLanguagePorter     = searchservice.SnowballTokenFilterLanguagePorter
+	SnowballTokenFilterLanguagePortuguese = searchservice.SnowballTokenFilterLanguagePortuguese
+	SnowballTokenFilterLanguageRomanian   = searchservice.SnowballTokenFilterLanguageRomanian
+	SnowballTokenFilterLanguageRussian    = searchservice.SnowballTokenFilterLanguageRussian
+	SnowballTokenFilterLanguageSpanish    = searchservice.SnowballTokenFilterLanguageSpanish
+	SnowballTokenFilterLanguageSwedish    = searchservice.SnowballTokenFilterLanguageSwedish
+	SnowballTokenFilterLanguageTurkish    = searchservice.SnowballTokenFilterLanguageTurkish
+)
+
+// SplitSkillLanguage values re-exported from searchservice.
+const (
+	SplitSkillLanguageAm   = searchservice.SplitSkillLanguageAm
+	SplitSkillLanguageBs   = searchservice.SplitSkillLanguageBs
+	SplitSkillLanguageCs   = searchservice.SplitSkillLanguageCs
+	SplitSkillLanguageDa   = searchservice.SplitSkillLanguageDa
+	SplitSkillLanguageDe   = searchservice.SplitSkillLanguageDe
+	SplitSkillLanguageEn   = searchservice.SplitSkillLanguageEn
+	SplitSkillLanguageEs   = searchservice.SplitSkillLanguageEs
+	SplitSkillLanguageEt   = searchservice.SplitSkillLanguageEt
+	SplitSkillLanguageFi   = searchservice.SplitSkillLanguageFi
+	SplitSkillLanguageFr   = searchservice.SplitSkillLanguageFr
+	SplitSkillLanguageHe   = searchservice.SplitSkillLanguageHe
+	SplitSkillLanguageHi   = searchservice.SplitSkillLanguageHi
+	SplitSkillLanguageHr   = searchservice.SplitSkillLanguageHr
+	SplitSkillLanguageHu   = searchservice.SplitSkillLanguageHu
+	SplitSkillLanguageID   = searchservice.SplitSkillLanguageID
+	SplitSkillLanguageIs   = searchservice.SplitSkillLanguageIs
+	SplitSkillLanguageIt   = searchservice.SplitSkillLanguageIt
+	SplitSkillLanguageJa   = searchservice.SplitSkillLanguageJa
+	SplitSkillLanguageKo   = searchservice.SplitSkillLanguageKo
+	SplitSkillLanguageLv   = searchservice.SplitSkillLanguageLv
+	SplitSkillLanguageNb   = searchservice.SplitSkillLanguageNb
+	SplitSkillLanguageNl   = searchservice.SplitSkillLanguageNl
+	SplitSkillLanguagePl   = searchservice.SplitSkillLanguagePl
+	SplitSkillLanguagePt   = searchservice.SplitSkillLanguagePt
+	SplitSkillLanguagePtBr = searchservice.SplitSkillLanguagePtBr
+	SplitSkillLanguageRu   = searchservice.SplitSkillLanguageRu
+	SplitSkillLanguageSk   = searchservice.SplitSkillLanguageSk
+	SplitSkillLanguageSl   = searchservice.SplitSkillLanguageSl
+	SplitSkillLanguageSr   = searchservice.SplitSkillLanguageSr
+	SplitSkillLanguageSv   = searchservice.SplitSkillLanguageSv
+	SplitSkillLanguageTr   = searchservice.SplitSkillLanguageTr
+	SplitSkillLanguageUr   = searchservice.SplitSkillLanguageUr
+	SplitSkillLanguageZh   = searchservice.SplitSkillLanguageZh
+)
+
+// StemmerTokenFilterLanguage values re-exported from searchservice.
+const (
+	StemmerTokenFilterLanguageArabic            = searchservice.StemmerTokenFilterLanguageArabic
+	StemmerTokenFilterLanguageArmenian          = searchservice.StemmerTokenFilterLanguageArmenian
+	StemmerTokenFilterLanguageBasque            = searchservice.StemmerTokenFilterLanguageBasque
+	StemmerTokenFilterLanguageBrazilian         = searchservice.StemmerTokenFilterLanguageBrazilian
+	StemmerTokenFilterLanguageBulgarian         = searchservice.StemmerTokenFilterLanguageBulgarian
+	StemmerTokenFilterLanguageCatalan           = searchservice.StemmerTokenFilterLanguageCatalan
+	StemmerTokenFilterLanguageCzech             = searchservice.StemmerTokenFilterLanguageCzech
+	StemmerTokenFilterLanguageDanish            = searchservice.StemmerTokenFilterLanguageDanish
+	StemmerTokenFilterLanguageDutch             = searchservice.StemmerTokenFilterLanguageDutch
+	StemmerTokenFilterLanguageDutchKp           = searchservice.StemmerTokenFilterLanguageDutchKp
+	StemmerTokenFilterLanguageEnglish           = searchservice.StemmerTokenFilterLanguageEnglish
+	StemmerTokenFilterLanguageFinnish           = searchservice.StemmerTokenFilterLanguageFinnish
+	StemmerTokenFilterLanguageFrench            = searchservice.StemmerTokenFilterLanguageFrench
+	StemmerTokenFilterLanguageGalician          = searchservice.StemmerTokenFilterLanguageGalician
+	StemmerTokenFilterLanguageGerman            = searchservice.StemmerTokenFilterLanguageGerman
+	StemmerTokenFilterLanguageGerman2           = searchservice.StemmerTokenFilterLanguageGerman2
+	StemmerTokenFilterLanguageGreek             = searchservice.StemmerTokenFilterLanguageGreek
+	StemmerTokenFilterLanguageHindi             = searchservice.StemmerTokenFilterLanguageHindi
+	StemmerTokenFilterLanguageHungarian         = searchservice.StemmerTokenFilterLanguageHungarian
+	StemmerTokenFilterLanguageIndonesian        = searchservice.StemmerTokenFilterLanguageIndonesian
+	StemmerTokenFilterLanguageIrish             = searchservice.StemmerTokenFilterLanguageIrish
+	StemmerTokenFilterLanguageItalian           = searchservice.StemmerTokenFilterLanguageItalian
+	StemmerTokenFilterLanguageLatvian           = searchservice.StemmerTokenFilterLanguageLatvian
+	StemmerTokenFilterLanguageLightEnglish      = searchservice.StemmerTokenFilterLanguageLightEnglish
+	StemmerTokenFilterLanguageLightFinnish      = searchservice.StemmerTokenFilterLanguageLightFinnish
+	StemmerTokenFilterLanguageLightFrench       = searchservice.StemmerTokenFilterLanguageLightFrench
+	StemmerTokenFilterLanguageLightGerman       = searchservice.StemmerTokenFilterLanguageLightGerman
+	StemmerTokenFilterLanguageLightHungarian    = searchservice.StemmerTokenFilterLanguageLightHungarian
+	StemmerTokenFilterLanguageLightItalian      = searchservice.StemmerTokenFilterLanguageLightItalian
+	StemmerTokenFilterLanguageLightNorwegian    = searchservice.StemmerTokenFilterLanguageLightNorwegian
+	StemmerTokenFilterLanguageLightNynorsk      = searchservice.StemmerTokenFilterLanguageLightNynorsk
+	StemmerTokenFilterLanguageLightPortuguese   = searchservice.StemmerTokenFilterLanguageLightPortuguese
+	StemmerTokenFilterLanguageLightRussian      = searchservice.StemmerTokenFilterLanguageLightRussian
+	StemmerTokenFilterLanguageLightSpanish      = searchservice.StemmerTokenFilterLanguageLightSpanish
+	StemmerTokenFilterLanguageLightSwedish      = searchservice.StemmerTokenFilterLanguageLightSwedish
+	StemmerTokenFilterLanguageLovins            = searchservice.StemmerTokenFilterLanguageLovins
+	StemmerTokenFilterLanguageMinimalEnglish    = searchservice.StemmerTokenFilterLanguageMinimalEnglish
+	StemmerTokenFilterLanguageMinimalFrench     = searchservice.StemmerTokenFilterLanguageMinimalFrench
+	StemmerTokenFilterLanguageMinimalGalician   = searchservice.StemmerTokenFilterLanguageMinimalGalician
+	StemmerTokenFilterLanguageMinimalGerman     = searchservice.StemmerTokenFilterLanguageMinimalGerman
+	StemmerTokenFilterLanguageMinimalNorwegian  = searchservice.StemmerTokenFilterLanguageMinimalNorwegian
+	StemmerTokenFilterLanguageMinimalNynorsk    = searchservice.StemmerTokenFilterLanguageMinimalNynorsk
+	StemmerTokenFilterLanguageMinimalPortuguese = searchservice.StemmerTokenFilterLanguageMinimalPortuguese
+	StemmerTokenFilterLanguageNorwegian         = searchservice.StemmerTokenFilterLanguageNorwegian
+	StemmerTokenFilterLanguagePorter2           = searchservice.StemmerTokenFilterLanguagePorter2
+	StemmerTokenFilterLanguagePortuguese        = searchservice.StemmerTokenFilterLanguagePortuguese
+	StemmerTokenFilterLanguagePortugueseRslp    = searchservice.StemmerTokenFilterLanguagePortugueseRslp
+	StemmerTokenFilterLanguagePossessiveEnglish = searchservice.StemmerTokenFilterLanguagePossessiveEnglish
+	StemmerTokenFilterLanguageRomanian          = searchservice.StemmerTokenFilterLanguageRomanian
+	StemmerTokenFilterLanguageRussian           = searchservice.StemmerTokenFilterLanguageRussian
+	StemmerTokenFilterLanguageSorani            = searchservice.StemmerTokenFilterLanguageSorani
+	StemmerTokenFilterLanguageSpanish           = searchservice.StemmerTokenFilterLanguageSpanish
+	StemmerTokenFilterLanguageSwedish           = searchservice.StemmerTokenFilterLanguageSwedish
+	StemmerTokenFilterLanguageTurkish           = searchservice.StemmerTokenFilterLanguageTurkish
+)
+
+// StopwordsList values re-exported from searchservice.
+const (
+	StopwordsListArabic     = searchservice.StopwordsListArabic
+	StopwordsListArmenian   = searchservice.StopwordsListArmenian
+	StopwordsListBasque     = searchservice.StopwordsListBasque
+	StopwordsListBrazilian  = searchservice.StopwordsListBrazilian
+	StopwordsListBulgarian  = searchservice.StopwordsListBulgarian
+	StopwordsListCatalan    = searchservice.StopwordsListCatalan
+	StopwordsListCzech      = searchservice.StopwordsListCzech
+	StopwordsListDanish     = searchservice.StopwordsListDanish
+	StopwordsListDutch      = searchservice.StopwordsListDutch
+	StopwordsListEnglish    = searchservice.StopwordsListEnglish
+	StopwordsListFinnish    = searchservice.StopwordsListFinnish
+	StopwordsListFrench     = searchservice.StopwordsListFrench
+	StopwordsListGalician   = searchservice.StopwordsListGalician
+	StopwordsListGerman     = searchservice.StopwordsListGerman
+	StopwordsListGreek      = searchservice.StopwordsListGreek
+	StopwordsListHindi      = searchservice.StopwordsListHindi
+	StopwordsListHungarian  = searchservice.StopwordsListHungarian
+	StopwordsListIndonesian = searchservice.StopwordsListIndonesian
+	StopwordsListIrish      = searchservice.StopwordsListIrish
+	StopwordsListItalian    = searchservice.StopwordsListItalian
+	StopwordsListLatvian    = searchservice.StopwordsListLatvian
+	StopwordsListNorwegian  = searchservice.StopwordsListNorwegian
+	StopwordsListPersian    = searchservice.StopwordsListPersian
+	StopwordsListPortuguese = searchservice.StopwordsListPortuguese
+	StopwordsListRomanian   = searchservice.StopwordsListRomanian
+	StopwordsListRussian    = searchservice.StopwordsListRussian
+	StopwordsListSorani     = searchservice.StopwordsListSorani
+	StopwordsListSpanish    = searchservice.StopwordsListSpanish
+	StopwordsListSwedish    = searchservice.StopwordsListSwedish
+	StopwordsListThai       = searchservice.StopwordsListThai
+	StopwordsListTurkish    = searchservice.StopwordsListTurkish
+)
+
+// TextSplitMode values re-exported from searchservice.
+const (
+	TextSplitModePages     = searchservice.TextSplitModePages
+	TextSplitModeSentences = searchservice.TextSplitModeSentences
+)
+
+// TextTranslationSkillLanguage values re-exported from searchservice.
+const (
+	TextTranslationSkillLanguageAf      = searchservice.TextTranslationSkillLanguageAf
+	TextTranslationSkillLanguageAr      = searchservice.TextTranslationSkillLanguageAr
+	TextTranslationSkillLanguageBg      = searchservice.TextTranslationSkillLanguageBg
+	TextTranslationSkillLanguageBn      = searchservice.TextTranslationSkillLanguageBn
+	TextTranslationSkillLanguageBs      = searchservice.TextTranslationSkillLanguageBs
+	TextTranslationSkillLanguageCa      = searchservice.TextTranslationSkillLanguageCa
+	TextTranslationSkillLanguageCs      = searchservice.TextTranslationSkillLanguageCs
+	TextTranslationSkillLanguageCy      = searchservice.TextTranslationSkillLanguageCy
+	TextTranslationSkillLanguageDa      = searchservice.TextTranslationSkillLanguageDa
+	TextTranslationSkillLanguageDe      = searchservice.TextTranslationSkillLanguageDe
+	TextTranslationSkillLanguageEl      = searchservice.TextTranslationSkillLanguageEl
+	TextTranslationSkillLanguageEn      = searchservice.TextTranslationSkillLanguageEn
+	TextTranslationSkillLanguageEs      = searchservice.TextTranslationSkillLanguageEs
+	TextTranslationSkillLanguageEt      = searchservice.TextTranslationSkillLanguageEt
+	TextTranslationSkillLanguageFa      = searchservice.TextTranslationSkillLanguageFa
+	TextTranslationSkillLanguageFi      = searchservice.TextTranslationSkillLanguageFi
+	TextTranslationSkillLanguageFil     = searchservice.TextTranslationSkillLanguageFil
+	TextTranslationSkillLanguageFj      = searchservice.TextTranslationSkillLanguageFj
+	TextTranslationSkillLanguageFr      = searchservice.TextTranslationSkillLanguageFr
+	TextTranslationSkillLanguageGa      = searchservice.TextTranslationSkillLanguageGa
+	TextTranslationSkillLanguageHe      = searchservice.TextTranslationSkillLanguageHe
+	TextTranslationSkillLanguageHi      = searchservice.TextTranslationSkillLanguageHi
+	TextTranslationSkillLanguageHr      = searchservice.TextTranslationSkillLanguageHr
+	TextTranslationSkillLanguageHt      = searchservice.TextTranslationSkillLanguageHt
+	TextTranslationSkillLanguageHu      = searchservice.TextTranslationSkillLanguageHu
+	TextTranslationSkillLanguageID      = searchservice.TextTranslationSkillLanguageID
+	TextTranslationSkillLanguageIs      = searchservice.TextTranslationSkillLanguageIs
+	TextTranslationSkillLanguageIt      = searchservice.TextTranslationSkillLanguageIt
+	TextTranslationSkillLanguageJa      = searchservice.TextTranslationSkillLanguageJa
+	TextTranslationSkillLanguageKn      = searchservice.TextTranslationSkillLanguageKn
+	TextTranslationSkillLanguageKo      = searchservice.TextTranslationSkillLanguageKo
+	TextTranslationSkillLanguageLt      = searchservice.TextTranslationSkillLanguageLt
+	TextTranslationSkillLanguageLv      = searchservice.TextTranslationSkillLanguageLv
+	TextTranslationSkillLanguageMg      = searchservice.TextTranslationSkillLanguageMg
+	TextTranslationSkillLanguageMi      = searchservice.TextTranslationSkillLanguageMi
+	TextTranslationSkillLanguageMl      = searchservice.TextTranslationSkillLanguageMl
+	TextTranslationSkillLanguageMs      = searchservice.TextTranslationSkillLanguageMs
+	TextTranslationSkillLanguageMt      = searchservice.TextTranslationSkillLanguageMt
+	TextTranslationSkillLanguageMww     = searchservice.TextTranslationSkillLanguageMww
+	TextTranslationSkillLanguageNb      = searchservice.TextTranslationSkillLanguageNb
+	TextTranslationSkillLanguageNl      = searchservice.TextTranslationSkillLanguageNl
+	TextTranslationSkillLanguageOtq     = searchservice.TextTranslationSkillLanguageOtq
+	TextTranslationSkillLanguagePa      = searchservice.TextTranslationSkillLanguagePa
+	TextTranslationSkillLanguagePl      = searchservice.TextTranslationSkillLanguagePl
+	TextTranslationSkillLanguagePt      = searchservice.TextTranslationSkillLanguagePt
+	TextTranslationSkillLanguagePtBr    = searchservice.TextTranslationSkillLanguagePtBr
+	TextTranslationSkillLanguagePtPT    = searchservice.TextTranslationSkillLanguagePtPT
+	TextTranslationSkillLanguageRo      = searchservice.TextTranslationSkillLanguageRo
+	TextTranslationSkillLanguageRu      = searchservice.TextTranslationSkillLanguageRu
+	TextTranslationSkillLanguageSk      = searchservice.TextTranslationSkillLanguageSk
+	TextTranslationSkillLanguageSl      = searchservice.TextTranslationSkillLanguageSl
+	TextTranslationSkillLanguageSm      = searchservice.TextTranslationSkillLanguageSm
+	TextTranslationSkillLanguageSrCyrl  = searchservice.TextTranslationSkillLanguageSrCyrl
+	TextTranslationSkillLanguageSrLatn  = searchservice.TextTranslationSkillLanguageSrLatn
+	TextTranslationSkillLanguageSv      = searchservice.TextTranslationSkillLanguageSv
+	TextTranslationSkillLanguageSw      = searchservice.TextTranslationSkillLanguageSw
+	TextTranslationSkillLanguageTa      = searchservice.TextTranslationSkillLanguageTa
+	TextTranslationSkillLanguageTe      = searchservice.TextTranslationSkillLanguageTe
+	TextTranslationSkillLanguageTh      = searchservice.TextTranslationSkillLanguageTh
+	TextTranslationSkillLanguageTlh     = searchservice.TextTranslationSkillLanguageTlh
+	TextTranslationSkillLanguageTlhLatn = searchservice.TextTranslationSkillLanguageTlhLatn
+	TextTranslationSkillLanguageTlhPiqd = searchservice.TextTranslationSkillLanguageTlhPiqd
+	TextTranslationSkillLanguageTo      = searchservice.TextTranslationSkillLanguageTo
+	TextTranslationSkillLanguageTr      = searchservice.TextTranslationSkillLanguageTr
+	TextTranslationSkillLanguageTy      = searchservice.TextTranslationSkillLanguageTy
+	TextTranslationSkillLanguageUk      = searchservice.TextTranslationSkillLanguageUk
+	TextTranslationSkillLanguageUr      = searchservice.TextTranslationSkillLanguageUr
+	TextTranslationSkillLanguageVi      = searchservice.TextTranslationSkillLanguageVi
+	TextTranslationSkillLanguageYua     = searchservice.TextTranslationSkillLanguageYua
+	TextTranslationSkillLanguageYue     = searchservice.TextTranslationSkillLanguageYue
+	TextTranslationSkillLanguageZhHans  = searchservice.TextTranslationSkillLanguageZhHans
+	TextTranslationSkillLanguageZhHant  = searchservice.TextTranslationSkillLanguageZhHant
+)
+
+// TokenCharacterKind values re-exported from searchservice.
+const (
+	TokenCharacterKindDigit       = searchservice.TokenCharacterKindDigit
+	TokenCharacterKindLetter      = searchservice.TokenCharacterKindLetter
+	TokenCharacterKindPunctuation = searchservice.TokenCharacterKindPunctuation
+	TokenCharacterKindSymbol      = searchservice.TokenCharacterKindSymbol
+	TokenCharacterKindWhitespace  = searchservice.TokenCharacterKindWhitespace
+)
+
+// TokenFilterName values re-exported from searchservice.
+const (
+	TokenFilterNameASCIIFolding                     = searchservice.TokenFilterNameASCIIFolding
+	TokenFilterNameApostrophe                       = searchservice.TokenFilterNameApostrophe
+	TokenFilterNameArabicNormalization              = searchservice.TokenFilterNameArabicNormalization
+	TokenFilterNameCjkBigram                        = searchservice.TokenFilterNameCjkBigram
+	TokenFilterNameCjkWidth                         = searchservice.TokenFilterNameCjkWidth
+	TokenFilterNameClassic                          = searchservice.TokenFilterNameClassic
+	TokenFilterNameCommonGram                       = searchservice.TokenFilterNameCommonGram
+	TokenFilterNameEdgeNGram                        = searchservice.TokenFilterNameEdgeNGram
+	TokenFilterNameElision                          = searchservice.TokenFilterNameElision
+	TokenFilterNameGermanNormalization              = searchservice.TokenFilterNameGermanNormalization
+	TokenFilterNameHindiNormalization               = searchservice.TokenFilterNameHindiNormalization
+	TokenFilterNameIndicNormalization               = searchservice.TokenFilterNameIndicNormalization
+	TokenFilterNameKStem                            = searchservice.TokenFilterNameKStem
+	TokenFilterNameKeywordRepeat                    = searchservice.TokenFilterNameKeywordRepeat
+	TokenFilterNameLength                           = searchservice.TokenFilterNameLength
+	TokenFilterNameLimit                            = searchservice.TokenFilterNameLimit
+	TokenFilterNameLowercase                        = searchservice.TokenFilterNameLowercase
+	TokenFilterNameNGram                            = searchservice.TokenFilterNameNGram
+	TokenFilterNamePersianNormalization             = searchservice.TokenFilterNamePersianNormalization
+	TokenFilterNamePhonetic                         = searchservice.TokenFilterNamePhonetic
+	TokenFilterNamePorterStem                       = searchservice.TokenFilterNamePorterStem
+	TokenFilterNameReverse                          = searchservice.TokenFilterNameReverse
+	TokenFilterNameScandinavianFoldingNormalization = searchservice.TokenFilterNameScandinavianFoldingNormalization
+	TokenFilterNameScandinavianNormalization        = searchservice.TokenFilterNameScandinavianNormalization
+	TokenFilterNameShingle                          = searchservice.TokenFilterNameShingle
+	TokenFilterNameSnowball                         = searchservice.TokenFilterNameSnowball
+	TokenFilterNameSoraniNormalization              = searchservice.TokenFilterNameSoraniNormalization
+	TokenFilterNameStemmer                          = searchservice.TokenFilterNameStemmer
+	TokenFilterNameStopwords                        = searchservice.TokenFilterNameStopwords
+	TokenFilterNameTrim                             = searchservice.TokenFilterNameTrim
+	TokenFilterNameTruncate                         = searchservice.TokenFilterNameTruncate
+	TokenFilterNameUnique                           = searchservice.TokenFilterNameUnique
+	TokenFilterNameUppercase                        = searchservice.TokenFilterNameUppercase
+	TokenFilterNameWordDelimiter                    = searchservice.TokenFilterNameWordDelimiter
+)
+
+// VectorEncodingFormat values re-exported from searchservice.
+const (
+	VectorEncodingFormatPackedBit = searchservice.VectorEncodingFormatPackedBit
+)
+
+// VectorSearchAlgorithmKind values re-exported from searchservice.
+const (
+	VectorSearchAlgorithmKindExhaustiveKnn = searchservice.VectorSearchAlgorithmKindExhaustiveKnn
+	VectorSearchAlgorithmKindHnsw          = searchservice.VectorSearchAlgorithmKindHnsw
+)
+
+// VectorSearchAlgorithmMetric values re-exported from searchservice.
+const (
+	VectorSearchAlgorithmMetricCosine     = searchservice.VectorSearchAlgorithmMetricCosine
+	VectorSearchAlgorithmMetricDotProduct = searchservice.VectorSearchAlgorithmMetricDotProduct
+	VectorSearchAlgorithmMetricEuclidean  = searchservice.VectorSearchAlgorithmMetricEuclidean
+	VectorSearchAlgorithmMetricHamming    = searchservice.VectorSearchAlgorithmMetricHamming
+)
+
+// VectorSearchCompressionKind values re-exported from searchservice.
+const (
+	VectorSearchCompressionKindBinaryQuantization = searchservice.VectorSearchCompressionKindBinaryQuantization
+	VectorSearchCompressionKindScalarQuantization = searchservice.VectorSearchCompressionKindScalarQuantization
+)
+
+// VectorSearchCompressionRescoreStorageMethod values re-exported from searchservice.
+const (
+	VectorSearchCompressionRescoreStorageMethodDiscardOriginals  = searchservice.VectorSearchCompressionRescoreStorageMethodDiscardOriginals
+	VectorSearchCompressionRescoreStorageMethodPreserveOriginals = searchservice.VectorSearchCompressionRescoreStorageMethodPreserveOriginals
+)
+
+// VectorSearchCompressionTargetDataType values re-exported from searchservice.
+const (
+	VectorSearchCompressionTargetDataTypeInt8 = searchservice.VectorSearchCompressionTargetDataTypeInt8
+)
+
+// VectorSearchVectorizerKind values re-exported from searchservice.
+const (
+	VectorSearchVectorizerKindAzureOpenAI  = searchservice.VectorSearchVectorizerKindAzureOpenAI
+	VectorSearchVectorizerKindCustomWebAPI = searchservice.VectorSearchVectorizerKindCustomWebAPI
+)
+
+// VisualFeature values re-exported from searchservice.
+const (
+	VisualFeatureAdult       = searchservice.VisualFeatureAdult
+	VisualFeatureBrands      = searchservice.VisualFeatureBrands
+	VisualFeatureCategories  = searchservice.VisualFeatureCategories
+	VisualFeatureDescription = searchservice.VisualFeatureDescription
+	VisualFeatureFaces       = searchservice.VisualFeatureFaces
+	VisualFeatureObjects     = searchservice.VisualFeatureObjects
+	VisualFeatureTags        = searchservice.VisualFeatureTags
+)
+
+// ParseDateTimeOffset re-exports searchservice.ParseDateTimeOffset.
+var ParseDateTimeOffset = searchservice.ParseDateTimeOffset
+
+// PossibleAzureOpenAIModelNameValues re-exports searchservice.PossibleAzureOpenAIModelNameValues.
+var PossibleAzureOpenAIModelNameValues = searchservice.PossibleAzureOpenAIModelNameValues
+
+// PossibleBlobIndexerDataToExtractValues re-exports searchservice.PossibleBlobIndexerDataToExtractValues.
+var PossibleBlobIndexerDataToExtractValues = searchservice.PossibleBlobIndexerDataToExtractValues
+
+// PossibleBlobIndexerImageActionValues re-exports searchservice.PossibleBlobIndexerImageActionValues.
+var PossibleBlobIndexerImageActionValues = searchservice.PossibleBlobIndexerImageActionValues
+
+// PossibleBlobIndexerPDFTextRotationAlgorithmValues re-exports searchservice.PossibleBlobIndexerPDFTextRotationAlgorithmValues.
+var PossibleBlobIndexerPDFTextRotationAlgorithmValues = searchservice.PossibleBlobIndexerPDFTextRotationAlgorithmValues
+
+// PossibleBlobIndexerParsingModeValues re-exports searchservice.PossibleBlobIndexerParsingModeValues.
+var PossibleBlobIndexerParsingModeValues = searchservice.PossibleBlobIndexerParsingModeValues
+
+// PossibleCharFilterNameValues re-exports searchservice.PossibleCharFilterNameValues.
+var PossibleCharFilterNameValues = searchservice.PossibleCharFilterNameValues
+
+// PossibleCjkBigramTokenFilterScriptsValues re-exports searchservice.PossibleCjkBigramTokenFilterScriptsValues.
+var PossibleCjkBigramTokenFilterScriptsValues = searchservice.PossibleCjkBigramTokenFilterScriptsValues
+
+// PossibleCustomEntityLookupSkillLanguageValues re-exports searchservice.PossibleCustomEntityLookupSkillLanguageValues.
+var PossibleCustomEntityLookupSkillLanguageValues = searchservice.PossibleCustomEntityLookupSkillLanguageValues
+
+// PossibleDocumentIntelligenceLayoutSkillChunkingUnitValues re-exports searchservice.PossibleDocumentIntelligenceLayoutSkillChunkingUnitValues.
+var PossibleDocumentIntelligenceLayoutSkillChunkingUnitValues = searchservice.PossibleDocumentIntelligenceLayoutSkillChunkingUnitValues
+
+// PossibleDocumentIntelligenceLayoutSkillExtractionOptionsValues re-exports searchservice.PossibleDocumentIntelligenceLayoutSkillExtractionOptionsValues.
+var PossibleDocumentIntelligenceLayoutSkillExtractionOptionsValues = searchservice.PossibleDocumentIntelligenceLayoutSkillExtractionOptionsValues
+
+// PossibleDocumentIntelligenceLayoutSkillMarkdownHeaderDepthValues re-exports searchservice.PossibleDocumentIntelligenceLayoutSkillMarkdownHeaderDepthValues.
+var PossibleDocumentIntelligenceLayoutSkillMarkdownHeaderDepthValues = searchservice.PossibleDocumentIntelligenceLayoutSkillMarkdownHeaderDepthValues
+
+// PossibleDocumentIntelligenceLayoutSkillOutputFormatValues re-exports searchservice.PossibleDocumentIntelligenceLayoutSkillOutputFormatValues.
+var PossibleDocumentIntelligenceLayoutSkillOutputFormatValues = searchservice.PossibleDocumentIntelligenceLayoutSkillOutputFormatValues
+
+// PossibleDocumentIntelligenceLayoutSkillOutputModeValues re-exports searchservice.PossibleDocumentIntelligenceLayoutSkillOutputModeValues.
+var PossibleDocumentIntelligenceLayoutSkillOutputModeValues = searchservice.PossibleDocumentIntelligenceLayoutSkillOutputModeValues
+
+// PossibleEdgeNGramTokenFilterSideValues re-exports searchservice.PossibleEdgeNGramTokenFilterSideValues.
+var PossibleEdgeNGramTokenFilterSideValues = searchservice.PossibleEdgeNGramTokenFilterSideValues
+
+// PossibleEntityCategoryValues re-exports searchservice.PossibleEntityCategoryValues.
+var PossibleEntityCategoryValues = searchservice.PossibleEntityCategoryValues
+
+// PossibleEntityRecognitionSkillLanguageValues re-exports searchservice.PossibleEntityRecognitionSkillLanguageValues.
+var PossibleEntityRecognitionSkillLanguageValues = searchservice.PossibleEntityRecognitionSkillLanguageValues
+
+// PossibleEnum0Values re-exports searchservice.PossibleEnum0Values.
+var PossibleEnum0Values = searchservice.PossibleEnum0Values
+
+// PossibleImageAnalysisSkillLanguageValues re-exports searchservice.PossibleImageAnalysisSkillLanguageValues.
+var PossibleImageAnalysisSkillLanguageValues = searchservice.PossibleImageAnalysisSkillLanguageValues
+
+// PossibleImageDetailValues re-exports searchservice.PossibleImageDetailValues.
+var PossibleImageDetailValues = searchservice.PossibleImageDetailValues
+
+// PossibleIndexProjectionModeValues re-exports searchservice.PossibleIndexProjectionModeValues.
+var PossibleIndexProjectionModeValues = searchservice.PossibleIndexProjectionModeValues
+
+// PossibleIndexerExecutionEnvironmentValues re-exports searchservice.PossibleIndexerExecutionEnvironmentValues.
+var PossibleIndexerExecutionEnvironmentValues = searchservice.PossibleIndexerExecutionEnvironmentValues
+
+// PossibleIndexerExecutionStatusValues re-exports searchservice.PossibleIndexerExecutionStatusValues.
+var PossibleIndexerExecutionStatusValues = searchservice.PossibleIndexerExecutionStatusValues
+
+// PossibleIndexerStatusValues re-exports searchservice.PossibleIndexerStatusValues.
+var PossibleIndexerStatusValues = searchservice.PossibleIndexerStatusValues
+
+// PossibleKeyPhraseExtractionSkillLanguageValues re-exports searchservice.PossibleKeyPhraseExtractionSkillLanguageValues.
+var PossibleKeyPhraseExtractionSkillLanguageValues = searchservice.PossibleKeyPhraseExtractionSkillLanguageValues
+
+// PossibleLexicalAnalyzerNameValues re-exports searchservice.PossibleLexicalAnalyzerNameValues.
+var PossibleLexicalAnalyzerNameValues = searchservice.PossibleLexicalAnalyzerNameValues
+
+// PossibleLexicalNormalizerNameValues re-exports searchservice.PossibleLexicalNormalizerNameValues.
+var PossibleLexicalNormalizerNameValues = searchservice.PossibleLexicalNormalizerNameValues
+
+// PossibleLexicalTokenizerNameValues re-exports searchservice.PossibleLexicalTokenizerNameValues.
+var PossibleLexicalTokenizerNameValues = searchservice.PossibleLexicalTokenizerNameValues
+
+// PossibleLineEndingValues re-exports searchservice.PossibleLineEndingValues.
+var PossibleLineEndingValues = searchservice.PossibleLineEndingValues
+
+// PossibleMicrosoftStemmingTokenizerLanguageValues re-exports searchservice.PossibleMicrosoftStemmingTokenizerLanguageValues.
+var PossibleMicrosoftStemmingTokenizerLanguageValues = searchservice.PossibleMicrosoftStemmingTokenizerLanguageValues
+
+// PossibleMicrosoftTokenizerLanguageValues re-exports searchservice.PossibleMicrosoftTokenizerLanguageValues.
+var PossibleMicrosoftTokenizerLanguageValues = searchservice.PossibleMicrosoftTokenizerLanguageValues
+
+// PossibleOcrSkillLanguageValues re-exports searchservice.PossibleOcrSkillLanguageValues.
+var PossibleOcrSkillLanguageValues = searchservice.PossibleOcrSkillLanguageValues
+
+// PossiblePIIDetectionSkillMaskingModeValues re-exports searchservice.PossiblePIIDetectionSkillMaskingModeValues.
+var PossiblePIIDetectionSkillMaskingModeValues = searchservice.PossiblePIIDetectionSkillMaskingModeValues
+
+// PossiblePhoneticEncoderValues re-exports searchservice.PossiblePhoneticEncoderValues.
+var PossiblePhoneticEncoderValues = searchservice.PossiblePhoneticEncoderValues
+
+// PossibleRankingOrderValues re-exports searchservice.PossibleRankingOrderValues.
+var PossibleRankingOrderValues = searchservice.PossibleRankingOrderValues
+
+// PossibleRegexFlagsValues re-exports searchservice.PossibleRegexFlagsValues.
+var PossibleRegexFlagsValues = searchservice.PossibleRegexFlagsValues
+
+// PossibleScoringFunctionAggregationValues re-exports searchservice.PossibleScoringFunctionAggregationValues.
+var PossibleScoringFunctionAggregationValues = searchservice.PossibleScoringFunctionAggregationValues
+
+// PossibleScoringFunctionInterpolationValues re-exports searchservice.PossibleScoringFunctionInterpolationValues.
+var PossibleScoringFunctionInterpolationValues = searchservice.PossibleScoringFunctionInterpolationValues
+
+// PossibleSearchFieldDataTypeValues re-exports searchservice.PossibleSearchFieldDataTypeValues.
+var PossibleSearchFieldDataTypeValues = searchservice.PossibleSearchFieldDataTypeValues
+
+// PossibleSearchIndexerDataSourceTypeValues re-exports searchservice.PossibleSearchIndexerDataSourceTypeValues.
+var PossibleSearchIndexerDataSourceTypeValues = searchservice.PossibleSearchIndexerDataSourceTypeValues
+
+// PossibleSentimentSkillLanguageValues re-exports searchservice.PossibleSentimentSkillLanguageValues.
+var PossibleSentimentSkillLanguageValues = searchservice.PossibleSentimentSkillLanguageValues
+
+// PossibleSnowballTokenFilterLanguageValues re-exports searchservice.PossibleSnowballTokenFilterLanguageValues.
+var PossibleSnowballTokenFilterLanguageValues = searchservice.PossibleSnowballTokenFilterLanguageValues
+
+// PossibleSplitSkillLanguageValues re-exports searchservice.PossibleSplitSkillLanguageValues.
+var PossibleSplitSkillLanguageValues = searchservice.PossibleSplitSkillLanguageValues
+
+// PossibleStemmerTokenFilterLanguageValues re-exports searchservice.PossibleStemmerTokenFilterLanguageValues.
+var PossibleStemmerTokenFilterLanguageValues = searchservice.PossibleStemmerTokenFilterLanguageValues
+
+// PossibleStopwordsListValues re-exports searchservice.PossibleStopwordsListValues.
+var PossibleStopwordsListValues = searchservice.PossibleStopwordsListValues
+
+// PossibleTextSplitModeValues re-exports searchservice.PossibleTextSplitModeValues.
+var PossibleTextSplitModeValues = searchservice.PossibleTextSplitModeValues
+
+// PossibleTextTranslationSkillLanguageValues re-exports searchservice.PossibleTextTranslationSkillLanguageValues.
+var PossibleTextTranslationSkillLanguageValues = searchservice.PossibleTextTranslationSkillLanguageValues
+
+// PossibleTokenCharacterKindValues re-exports searchservice.PossibleTokenCharacterKindValues.
+var PossibleTokenCharacterKindValues = searchservice.PossibleTokenCharacterKindValues
+
+// PossibleTokenFilterNameValues re-exports searchservice.PossibleTokenFilterNameValues.
+var PossibleTokenFilterNameValues = searchservice.PossibleTokenFilterNameValues
+
+// PossibleVectorEncodingFormatValues re-exports searchservice.PossibleVectorEncodingFormatValues.
+var PossibleVectorEncodingFormatValues = searchservice.PossibleVectorEncodingFormatValues
+
+// PossibleVectorSearchAlgorithmKindValues re-exports searchservice.PossibleVectorSearchAlgorithmKindValues.
+var PossibleVectorSearchAlgorithmKindValues = searchservice.PossibleVectorSearchAlgorithmKindValues
+
+// PossibleVectorSearchAlgorithmMetricValues re-exports searchservice.PossibleVectorSearchAlgorithmMetricValues.
+var PossibleVectorSearchAlgorithmMetricValues = searchservice.PossibleVectorSearchAlgorithmMetricValues
+
+// PossibleVectorSearchCompressionKindValues re-exports searchservice.PossibleVectorSearchCompressionKindValues.
+var PossibleVectorSearchCompressionKindValues = searchservice.PossibleVectorSearchCompressionKindValues
+
+// PossibleVectorSearchCompressionRescoreStorageMethodValues re-exports searchservice.PossibleVectorSearchCompressionRescoreStorageMethodValues.
+var PossibleVectorSearchCompressionRescoreStorageMethodValues = searchservice.PossibleVectorSearchCompressionRescoreStorageMethodValues
+
+// PossibleVectorSearchCompressionTargetDataTypeValues re-exports searchservice.PossibleVectorSearchCompressionTargetDataTypeValues.
+var PossibleVectorSearchCompressionTargetDataTypeValues = searchservice.PossibleVectorSearchCompressionTargetDataTypeValues
+
+// PossibleVectorSearchVectorizerKindValues re-exports searchservice.PossibleVectorSearchVectorizerKindValues.
+var PossibleVectorSearchVectorizerKindValues = searchservice.PossibleVectorSearchVectorizerKindValues
+
+// PossibleVisualFeatureValues re-exports searchservice.PossibleVisualFeatureValues.
+var PossibleVisualFeatureValues = searchservice.PossibleVisualFeatureValues
+
+// Types re-exported from searchindex.
+type AutocompleteItem = searchindex.AutocompleteItem
+type AutocompleteMode = searchindex.AutocompleteMode
+type AutocompleteOptions = searchindex.AutocompleteOptions
+type AutocompleteRequest = searchindex.AutocompleteRequest
+type AutocompleteResult = searchindex.AutocompleteResult
+type DeleteByFilterOptions = searchindex.DeleteByFilterOptions
+type DeleteByFilterResult = searchindex.DeleteByFilterResult
+type DocumentDebugInfo = searchindex.DocumentDebugInfo
+type DocumentsClient = searchindex.DocumentsClient
+type DocumentsClientAutocompleteGetOptions = searchindex.DocumentsClientAutocompleteGetOptions
+type DocumentsClientAutocompleteGetResponse = searchindex.DocumentsClientAutocompleteGetResponse
+type DocumentsClientAutocompletePostOptions = searchindex.DocumentsClientAutocompletePostOptions
+type DocumentsClientAutocompletePostResponse = searchindex.DocumentsClientAutocompletePostResponse
+type DocumentsClientCountOptions = searchindex.DocumentsClientCountOptions
+type DocumentsClientCountResponse = searchindex.DocumentsClientCountResponse
+type DocumentsClientGetOptions = searchindex.DocumentsClientGetOptions
+type DocumentsClientGetResponse = searchindex.DocumentsClientGetResponse
+type DocumentsClientIndexOptions = searchindex.DocumentsClientIndexOptions
+type DocumentsClientIndexResponse = searchindex.DocumentsClientIndexResponse
+type DocumentsClientSearchGetOptions = searchindex.DocumentsClientSearchGetOptions
+type DocumentsClientSearchGetResponse = searchindex.DocumentsClientSearchGetResponse
+type DocumentsClientSearchOptions = searchindex.DocumentsClientSearchOptions
+type DocumentsClientSearchPostOptions = searchindex.DocumentsClientSearchPostOptions
+type DocumentsClientSearchPostResponse = searchindex.DocumentsClientSearchPostResponse
+type DocumentsClientSearchResponse = searchindex.DocumentsClientSearchResponse
+type DocumentsClientSuggestGetOptions = searchindex.DocumentsClientSuggestGetOptions
+type DocumentsClientSuggestGetResponse = searchindex.DocumentsClientSuggestGetResponse
+type DocumentsClientSuggestPostOptions = searchindex.DocumentsClientSuggestPostOptions
+type DocumentsClientSuggestPostResponse = searchindex.DocumentsClientSuggestPostResponse
+type DocumentsErrorAdditionalInfo = searchindex.ErrorAdditionalInfo
+type DocumentsErrorDetail = searchindex.ErrorDetail
+type DocumentsErrorResponse = searchindex.ErrorResponse
+type FacetResult = searchindex.FacetResult
+type GetManyOptions = searchindex.GetManyOptions
+type GetManyResult = searchindex.GetManyResult
+type IndexAction = searchindex.IndexAction
+type IndexActionType = searchindex.IndexActionType
+type IndexBatch = searchindex.IndexBatch
+type IndexDocumentsResult = searchindex.IndexDocumentsResult
+type IndexingResult = searchindex.IndexingResult
+type QueryAnswerResult = searchindex.QueryAnswerResult
+type QueryAnswerType = searchindex.QueryAnswerType
+type QueryCaptionResult = searchindex.QueryCaptionResult
+type QueryCaptionType = searchindex.QueryCaptionType
+type QueryDebugMode = searchindex.QueryDebugMode
+type QueryResultDocumentSubscores = searchindex.QueryResultDocumentSubscores
+type QueryType = searchindex.QueryType
+type DocumentsRequestOptions = searchindex.RequestOptions
+type ScanOptions = searchindex.ScanOptions
+type ScannedDocument = searchindex.ScannedDocument
+type ScoringStatistics = searchindex.ScoringStatistics
+type SearchDocumentsResult = searchindex.SearchDocumentsResult
+type SearchMode = searchindex.SearchMode
+type SearchOptions = searchindex.SearchOptions
+type SearchRequest = searchindex.SearchRequest
+type SearchResult = searchindex.SearchResult
+type SemanticErrorMode = searchindex.SemanticErrorMode
+type SemanticErrorReason = searchindex.SemanticErrorReason
+type SemanticSearchResultsType = searchindex.SemanticSearchResultsType
+type SingleVectorFieldResult = searchindex.SingleVectorFieldResult
+type SuggestDocumentsResult = searchindex.SuggestDocumentsResult
+type SuggestOptions = searchindex.SuggestOptions
+type SuggestRequest = searchindex.SuggestRequest
+type SuggestResult = searchindex.SuggestResult
+type TextResult = searchindex.TextResult
+type VectorFilterMode = searchindex.VectorFilterMode
+type VectorQuery = searchindex.VectorQuery
+type VectorQueryClassification = searchindex.VectorQueryClassification
+type VectorQueryKind = searchindex.VectorQueryKind
+type VectorizableTextQuery = searchindex.VectorizableTextQuery
+type VectorizedQuery = searchindex.VectorizedQuery
+type VectorsDebugInfo = searchindex.VectorsDebugInfo
+
+const (
+	DefaultIndexMaxRetries = searchindex.DefaultIndexMaxRetries
+	DefaultIndexRetryDelay = searchindex.DefaultIndexRetryDelay
+)
+
+const (
+	MaxIndexBatchActions = searchindex.MaxIndexBatchActions
+)
+
+const (
+	MaxSearchGetURLLength = searchindex.MaxSearchGetURLLength
+)
+
+// AutocompleteMode values re-exported from searchindex.
+const (
+	AutocompleteModeOneTerm            = searchindex.AutocompleteModeOneTerm
+	AutocompleteModeOneTermWithContext = searchindex.AutocompleteModeOneTermWithContext
+	AutocompleteModeTwoTerms           = searchindex.AutocompleteModeTwoTerms
+)
+
+// IndexActionType values re-exported from searchindex.
+const (
+	IndexActionTypeDelete        = searchindex.IndexActionTypeDelete
+	IndexActionTypeMerge         = searchindex.IndexActionTypeMerge
+	IndexActionTypeMergeOrUpload = searchindex.IndexActionTypeMergeOrUpload
+	IndexActionTypeUpload        = searchindex.IndexActionTypeUpload
+)
+
+// QueryAnswerType values re-exported from searchindex.
+const (
+	QueryAnswerTypeExtractive = searchindex.QueryAnswerTypeExtractive
+	QueryAnswerTypeNone       = searchindex.QueryAnswerTypeNone
+)
+
+// QueryCaptionType values re-exported from searchindex.
+const (
+	QueryCaptionTypeExtractive = searchindex.QueryCaptionTypeExtractive
+	QueryCaptionTypeNone       = searchindex.QueryCaptionTypeNone
+)
+
+// QueryDebugMode values re-exported from searchindex.
+const (
+	QueryDebugModeDisabled = searchindex.QueryDebugModeDisabled
+	QueryDebugModeVector   = searchindex.QueryDebugModeVector
+)
+
+// QueryType values re-exported from searchindex.
+const (
+	QueryTypeFull     = searchindex.QueryTypeFull
+	QueryTypeSemantic = searchindex.QueryTypeSemantic
+	QueryTypeSimple   = searchindex.QueryTypeSimple
+)
+
+// ScoringStatistics values re-exported from searchindex.
+const (
+	ScoringStatisticsGlobal = searchindex.ScoringStatisticsGlobal
+	ScoringStatisticsLocal  = searchindex.ScoringStatisticsLocal
+)
+
+// SearchMode values re-exported from searchindex.
+const (
+	SearchModeAll = searchindex.SearchModeAll
+	SearchModeAny = searchindex.SearchModeAny
+)
+
+// SemanticErrorMode values re-exported from searchindex.
+const (
+	SemanticErrorModeFail    = searchindex.SemanticErrorModeFail
+	SemanticErrorModePartial = searchindex.SemanticErrorModePartial
+)
+
+// SemanticErrorReason values re-exported from searchindex.
+const (
+	SemanticErrorReasonCapacityOverloaded = searchindex.SemanticErrorReasonCapacityOverloaded
+	SemanticErrorReasonMaxWaitExceeded    = searchindex.SemanticErrorReasonMaxWaitExceeded
+	SemanticErrorReasonTransient          = searchindex.SemanticErrorReasonTransient
+)
+
+// SemanticSearchResultsType values re-exported from searchindex.
+const (
+	SemanticSearchResultsTypeBaseResults     = searchindex.SemanticSearchResultsTypeBaseResults
+	SemanticSearchResultsTypeRerankedResults = searchindex.SemanticSearchResultsTypeRerankedResults
+)
+
+// VectorFilterMode values re-exported from searchindex.
+const (
+	VectorFilterModePostFilter = searchindex.VectorFilterModePostFilter
+	VectorFilterModePreFilter  = searchindex.VectorFilterModePreFilter
+)
+
+// VectorQueryKind values re-exported from searchindex.
+const (
+	VectorQueryKindText   = searchindex.VectorQueryKindText
+	VectorQueryKindVector = searchindex.VectorQueryKindVector
+)
+
+// IsRetriableIndexingStatus re-exports searchindex.IsRetriableIndexingStatus.
+var IsRetriableIndexingStatus = searchindex.IsRetriableIndexingStatus
+
+// PossibleAutocompleteModeValues re-exports searchindex.PossibleAutocompleteModeValues.
+var PossibleAutocompleteModeValues = searchindex.PossibleAutocompleteModeValues
+
+// PossibleIndexActionTypeValues re-exports searchindex.PossibleIndexActionTypeValues.
+var PossibleIndexActionTypeValues = searchindex.PossibleIndexActionTypeValues
+
+// PossibleQueryAnswerTypeValues re-exports searchindex.PossibleQueryAnswerTypeValues.
+var PossibleQueryAnswerTypeValues = searchindex.PossibleQueryAnswerTypeValues
+
+// PossibleQueryCaptionTypeValues re-exports searchindex.PossibleQueryCaptionTypeValues.
+var PossibleQueryCaptionTypeValues = searchindex.PossibleQueryCaptionTypeValues
+
+// PossibleQueryDebugModeValues re-exports searchindex.PossibleQueryDebugModeValues.
+var PossibleQueryDebugModeValues = searchindex.PossibleQueryDebugModeValues
+
+// PossibleQueryTypeValues re-exports searchindex.PossibleQueryTypeValues.
+var PossibleQueryTypeValues = searchindex.PossibleQueryTypeValues
+
+// PossibleScoringStatisticsValues re-exports searchindex.PossibleScoringStatisticsValues.
+var PossibleScoringStatisticsValues = searchindex.PossibleScoringStatisticsValues
+
+// PossibleSearchModeValues re-exports searchindex.PossibleSearchModeValues.
+var PossibleSearchModeValues = searchindex.PossibleSearchModeValues
+
+// PossibleSemanticErrorModeValues re-exports searchindex.PossibleSemanticErrorModeValues.
+var PossibleSemanticErrorModeValues = searchindex.PossibleSemanticErrorModeValues
+
+// PossibleSemanticErrorReasonValues re-exports searchindex.PossibleSemanticErrorReasonValues.
+var PossibleSemanticErrorReasonValues = searchindex.PossibleSemanticErrorReasonValues
+
+// PossibleSemanticSearchResultsTypeValues re-exports searchindex.PossibleSemanticSearchResultsTypeValues.
+var PossibleSemanticSearchResultsTypeValues = searchindex.PossibleSemanticSearchResultsTypeValues
+
+// PossibleVectorFilterModeValues re-exports searchindex.PossibleVectorFilterModeValues.
+var PossibleVectorFilterModeValues = searchindex.PossibleVectorFilterModeValues
+
+// PossibleVectorQueryKindValues re-exports searchindex.PossibleVectorQueryKindValues.
+var PossibleVectorQueryKindValues = searchindex.PossibleVectorQueryKindValues
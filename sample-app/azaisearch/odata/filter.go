@@ -0,0 +1,421 @@
+// Package odata builds OData $filter expressions for Azure AI Search, e.g. for
+// SearchRequest.Filter, SearchOptions.Filter, SuggestRequest.Filter and AutocompleteOptions.Filter.
+//
+//	expr := odata.And(
+//		odata.Field("category").Eq("Luxury"),
+//		odata.Field("rating").Ge(4),
+//		odata.Any("tags", func(t odata.Var) odata.Expr { return t.Eq("pool") }),
+//	)
+//	filter, err := odata.Render(expr) // category eq 'Luxury' and rating ge 4 and tags/any(x0: x0 eq 'pool')
+//
+// Literals are always escaped by the builder, so user input can be passed as values safely.
+package odata
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"sample-app/azaisearch/internal/searchin"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+// Expr is a boolean filter expression.
+type Expr interface {
+	write(w *writer)
+}
+
+// Render renders e as an OData $filter string.
+func Render(e Expr) (string, error) {
+	w := &writer{}
+	w.expr(e)
+	if w.err != nil {
+		return "", w.err
+	}
+	return w.sb.String(), nil
+}
+
+// writer renders expressions and records the fields they reference.
+type writer struct {
+	sb     strings.Builder
+	err    error
+	refs   []fieldRef
+	scopes map[string]string // lambda variable -> collection field path
+}
+
+// fieldRef is a reference to an index field, with the attribute the reference requires.
+type fieldRef struct {
+	path       string
+	searchable bool
+}
+
+func (w *writer) str(s string) {
+	w.sb.WriteString(s)
+}
+
+func (w *writer) literal(v any) {
+	s, err := Literal(v)
+	if err != nil && w.err == nil {
+		w.err = err
+	}
+	w.str(s)
+}
+
+func (w *writer) expr(e Expr) {
+	if e == nil {
+		if w.err == nil {
+			w.err = errors.New("nil filter expression")
+		}
+		return
+	}
+	e.write(w)
+}
+
+// operand writes e, wrapping and/or expressions in parentheses.
+func (w *writer) operand(e Expr) {
+	if _, ok := e.(logical); ok {
+		w.str("(")
+		w.expr(e)
+		w.str(")")
+		return
+	}
+	w.expr(e)
+}
+
+// ref records a reference to the field at path, resolving lambda variables, and returns its full path.
+func (w *writer) ref(variable string, path string, searchable bool) string {
+	full := path
+	if variable != "" {
+		collection, ok := w.scopes[variable]
+		if !ok {
+			if w.err == nil {
+				w.err = fmt.Errorf("lambda variable %q used outside of its any/all expression", variable)
+			}
+			return ""
+		}
+		full = collection
+		if path != "" {
+			full += "/" + path
+		}
+	}
+	w.refs = append(w.refs, fieldRef{path: full, searchable: searchable})
+	return full
+}
+
+// FieldRef refers to a field of the index, or to a field of the current element in an any/all lambda.
+// A FieldRef is itself an Expr for Edm.Boolean fields.
+type FieldRef struct {
+	variable string
+	path     string
+}
+
+// Field refers to the index field at path. Subfields of complex fields are separated by '/', e.g. "address/city".
+func Field(path string) FieldRef {
+	return FieldRef{path: path}
+}
+
+func (f FieldRef) String() string {
+	switch {
+	case f.variable == "":
+		return f.path
+	case f.path == "":
+		return f.variable
+	}
+	return f.variable + "/" + f.path
+}
+
+func (f FieldRef) write(w *writer) {
+	w.ref(f.variable, f.path, false)
+	w.str(f.String())
+}
+
+// Eq compares the field for equality with v.
+func (f FieldRef) Eq(v any) Expr { return comparison{f, "eq", v} }
+
+// Ne compares the field for inequality with v.
+func (f FieldRef) Ne(v any) Expr { return comparison{f, "ne", v} }
+
+// Gt tests whether the field is greater than v.
+func (f FieldRef) Gt(v any) Expr { return comparison{f, "gt", v} }
+
+// Ge tests whether the field is greater than or equal to v.
+func (f FieldRef) Ge(v any) Expr { return comparison{f, "ge", v} }
+
+// Lt tests whether the field is less than v.
+func (f FieldRef) Lt(v any) Expr { return comparison{f, "lt", v} }
+
+// Le tests whether the field is less than or equal to v.
+func (f FieldRef) Le(v any) Expr { return comparison{f, "le", v} }
+
+// IsNull tests whether the field is null.
+func (f FieldRef) IsNull() Expr { return comparison{f, "eq", nil} }
+
+// Var is the range variable of an any/all lambda. For collections of primitive values it
+// refers to the element itself; for collections of complex values use Var.Field.
+type Var struct {
+	FieldRef
+}
+
+// Field refers to the subfield at path of the current element.
+func (v Var) Field(path string) FieldRef {
+	return FieldRef{variable: v.variable, path: path}
+}
+
+// operand is the left-hand side of a comparison.
+type operand interface {
+	write(w *writer)
+}
+
+type comparison struct {
+	left  operand
+	op    string
+	right any
+}
+
+func (c comparison) write(w *writer) {
+	c.left.write(w)
+	w.str(" " + c.op + " ")
+	w.literal(c.right)
+}
+
+// logical is an and/or expression.
+type logical struct {
+	op    string
+	exprs []Expr
+}
+
+// And combines exprs with the and operator.
+func And(exprs ...Expr) Expr { return logical{"and", exprs} }
+
+// Or combines exprs with the or operator.
+func Or(exprs ...Expr) Expr { return logical{"or", exprs} }
+
+func (l logical) write(w *writer) {
+	if len(l.exprs) == 0 {
+		if w.err == nil {
+			w.err = fmt.Errorf("%s needs at least one expression", l.op)
+		}
+		return
+	}
+	if len(l.exprs) == 1 {
+		w.expr(l.exprs[0])
+		return
+	}
+	for i, e := range l.exprs {
+		if i > 0 {
+			w.str(" " + l.op + " ")
+		}
+		w.operand(e)
+	}
+}
+
+type not struct {
+	expr Expr
+}
+
+// Not negates e.
+func Not(e Expr) Expr { return not{e} }
+
+func (n not) write(w *writer) {
+	w.str("not (")
+	w.expr(n.expr)
+	w.str(")")
+}
+
+// Raw is an OData expression that is rendered verbatim. It is not escaped or validated;
+// never build it from user input.
+type Raw string
+
+func (r Raw) write(w *writer) {
+	w.str(string(r))
+}
+
+// lambda is an any/all expression over a collection field.
+type lambda struct {
+	collection FieldRef
+	op         string
+	body       func(Var) Expr
+}
+
+// Any tests whether any element of the collection field at path satisfies the expression returned by body.
+// With a nil body, it tests whether the collection is non-empty.
+func Any(path string, body func(Var) Expr) Expr {
+	return Field(path).Any(body)
+}
+
+// All tests whether all elements of the collection field at path satisfy the expression returned by body.
+func All(path string, body func(Var) Expr) Expr {
+	return Field(path).All(body)
+}
+
+// Any tests whether any element of the collection field satisfies the expression returned by body.
+// Use it on Var.Field for nested collections. With a nil body, it tests whether the collection is non-empty.
+func (f FieldRef) Any(body func(Var) Expr) Expr {
+	return lambda{collection: f, op: "any", body: body}
+}
+
+// All tests whether all elements of the collection field satisfy the expression returned by body.
+// Use it on Var.Field for nested collections.
+func (f FieldRef) All(body func(Var) Expr) Expr {
+	return lambda{collection: f, op: "all", body: body}
+}
+
+func (l lambda) write(w *writer) {
+	collection := w.ref(l.collection.variable, l.collection.path, false)
+	w.str(l.collection.String() + "/" + l.op + "(")
+	if l.body == nil {
+		if l.op == "all" && w.err == nil {
+			w.err = errors.New("all needs a lambda expression")
+		}
+		w.str(")")
+		return
+	}
+
+	// use a variable name that is unique within nested lambdas
+	name := fmt.Sprintf("x%d", len(w.scopes))
+	if w.scopes == nil {
+		w.scopes = map[string]string{}
+	}
+	w.scopes[name] = collection
+	defer delete(w.scopes, name)
+
+	w.str(name + ": ")
+	w.expr(l.body(Var{FieldRef{variable: name}}))
+	w.str(")")
+}
+
+type searchIn struct {
+	field     FieldRef
+	values    []string
+	delimiter string
+}
+
+// SearchIn tests whether the field equals one of values using search.in, which is much faster
+// than a disjunction of eq comparisons for long lists. A delimiter that doesn't occur in any of
+// the values is chosen automatically; rendering fails if every candidate occurs in some value.
+func SearchIn(field FieldRef, values ...string) Expr {
+	return searchIn{field: field, values: values}
+}
+
+// SearchInWithDelimiter is like SearchIn, but separates values with the given delimiter characters.
+// Rendering fails if a value contains one of them.
+func SearchInWithDelimiter(field FieldRef, delimiter string, values ...string) Expr {
+	return searchIn{field: field, values: values, delimiter: delimiter}
+}
+
+func (s searchIn) write(w *writer) {
+	args, err := searchin.Args(s.values, s.delimiter)
+	if err != nil && w.err == nil {
+		w.err = fmt.Errorf("search.in: %w", err)
+	}
+	w.str("search.in(")
+	s.field.write(w)
+	w.str(", " + args + ")")
+}
+
+// IsMatchOptions contains the optional parameters for IsMatch.
+type IsMatchOptions struct {
+	// Fields are the searchable fields to search; all searchable fields if empty.
+	Fields []string
+
+	// QueryType is the syntax of the search query, simple or full.
+	QueryType *searchindex.QueryType
+
+	// SearchMode is whether any or all of the terms must match.
+	SearchMode *searchindex.SearchMode
+
+	// Scoring uses search.ismatchscoring, so that matches contribute to the relevance score.
+	Scoring bool
+}
+
+type isMatch struct {
+	search  string
+	options IsMatchOptions
+}
+
+// IsMatch runs a full-text search query as part of the filter using search.ismatch.
+//   - search - the search query, in simple or full Lucene query syntax
+//   - options - IsMatchOptions contains the optional parameters, pass nil to accept the default values.
+func IsMatch(search string, options *IsMatchOptions) Expr {
+	m := isMatch{search: search}
+	if options != nil {
+		m.options = *options
+	}
+	return m
+}
+
+func (m isMatch) write(w *writer) {
+	fn := "search.ismatch"
+	if m.options.Scoring {
+		fn = "search.ismatchscoring"
+	}
+	w.str(fn + "(" + Quote(m.search))
+	hasMode := m.options.QueryType != nil || m.options.SearchMode != nil
+	if len(m.options.Fields) > 0 || hasMode {
+		for _, f := range m.options.Fields {
+			w.ref("", f, true)
+		}
+		w.str(", " + Quote(strings.Join(m.options.Fields, ",")))
+	}
+	if hasMode {
+		queryType, searchMode := searchindex.QueryTypeSimple, searchindex.SearchModeAny
+		if m.options.QueryType != nil {
+			queryType = *m.options.QueryType
+		}
+		if m.options.SearchMode != nil {
+			searchMode = *m.options.SearchMode
+		}
+		w.str(", " + Quote(string(queryType)) + ", " + Quote(string(searchMode)))
+	}
+	w.str(")")
+}
+
+// GeoDistanceRef is the distance in kilometers between a Edm.GeographyPoint field and a point,
+// to be compared with a number.
+type GeoDistanceRef struct {
+	field FieldRef
+	point GeoPoint
+}
+
+// GeoDistance returns the distance in kilometers between the field and point using geo.distance.
+func GeoDistance(field FieldRef, point GeoPoint) GeoDistanceRef {
+	return GeoDistanceRef{field: field, point: point}
+}
+
+func (g GeoDistanceRef) write(w *writer) {
+	w.str("geo.distance(")
+	g.field.write(w)
+	w.str(", ")
+	w.literal(g.point)
+	w.str(")")
+}
+
+// Lt tests whether the distance is less than km.
+func (g GeoDistanceRef) Lt(km float64) Expr { return comparison{g, "lt", km} }
+
+// Le tests whether the distance is less than or equal to km.
+func (g GeoDistanceRef) Le(km float64) Expr { return comparison{g, "le", km} }
+
+// Gt tests whether the distance is greater than km.
+func (g GeoDistanceRef) Gt(km float64) Expr { return comparison{g, "gt", km} }
+
+// Ge tests whether the distance is greater than or equal to km.
+func (g GeoDistanceRef) Ge(km float64) Expr { return comparison{g, "ge", km} }
+
+type geoIntersects struct {
+	field   FieldRef
+	polygon Polygon
+}
+
+// GeoIntersects tests whether the Edm.GeographyPoint field lies within polygon using geo.intersects.
+func GeoIntersects(field FieldRef, polygon Polygon) Expr {
+	return geoIntersects{field: field, polygon: polygon}
+}
+
+func (g geoIntersects) write(w *writer) {
+	w.str("geo.intersects(")
+	g.field.write(w)
+	w.str(", ")
+	w.literal(g.polygon)
+	w.str(")")
+}
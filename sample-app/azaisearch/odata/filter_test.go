@@ -0,0 +1,95 @@
+package odata
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		expr Expr
+		want string
+	}{
+		{"eq string", Field("category").Eq("Luxury"), "category eq 'Luxury'"},
+		{"quote escaping", Field("name").Eq("O'Brien"), "name eq 'O''Brien'"},
+		{"number", Field("rating").Ge(4), "rating ge 4"},
+		{"null", Field("category").IsNull(), "category eq null"},
+		{"time", Field("updated").Lt(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)), "updated lt 2024-01-02T03:04:05Z"},
+		{"and", And(Field("a").Eq(1), Field("b").Eq(2)), "a eq 1 and b eq 2"},
+		{"single and", And(Field("a").Eq(1)), "a eq 1"},
+		{"nested or", And(Field("a").Eq(1), Or(Field("b").Eq(2), Field("c").Eq(3))), "a eq 1 and (b eq 2 or c eq 3)"},
+		{"not", Not(Field("a").Eq(1)), "not (a eq 1)"},
+		{"any", Any("tags", func(v Var) Expr { return v.Eq("pool") }), "tags/any(x0: x0 eq 'pool')"},
+		{"any without body", Any("tags", nil), "tags/any()"},
+		{"nested lambdas", Any("rooms", func(r Var) Expr {
+			return r.Field("tags").Any(func(t Var) Expr { return t.Eq("view") })
+		}), "rooms/any(x0: x0/tags/any(x1: x1 eq 'view'))"},
+		{"geo distance", GeoDistance(Field("location"), Point(-122.1, 47.6)).Le(10), "geo.distance(location, geography'POINT(-122.1 47.6)') le 10"},
+		{"search.in", SearchIn(Field("city"), "Seattle", "Boston"), "search.in(city, 'Seattle,Boston', ',')"},
+		{"search.in with spaces", SearchIn(Field("city"), "New York", "Boston"), "search.in(city, 'New York,Boston', ',')"},
+		{"search.in avoids commas", SearchIn(Field("name"), "a,b", "c"), "search.in(name, 'a,b|c', '|')"},
+		{"search.in explicit delimiter", SearchInWithDelimiter(Field("name"), ";", "a b", "c"), "search.in(name, 'a b;c', ';')"},
+		{"search.in quotes", SearchIn(Field("name"), "O'Brien"), "search.in(name, 'O''Brien', ',')"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.expr)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr Expr
+		want string
+	}{
+		{"nil", nil, "nil filter expression"},
+		{"empty and", And(), "and needs at least one expression"},
+		{"all without body", All("tags", nil), "all needs a lambda expression"},
+		{"unsupported literal", Field("a").Eq(struct{}{}), "unsupported OData literal type"},
+		{"search.in delimiter in value", SearchInWithDelimiter(Field("a"), "|", "x|y"), "occurs in the values"},
+		{"search.in every delimiter used", SearchIn(Field("a"), ",|;~^#$!%&*+=/:@`"), "no delimiter found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Render(tt.expr)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Render() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{nil, "null"},
+		{true, "true"},
+		{int64(-9007199254740993), "-9007199254740993"},
+		{2.5, "2.5"},
+		{float32(0.1), "0.1"},
+		{"it's", "'it''s'"},
+		{Polygon{Point(0, 0), Point(1, 0), Point(1, 1)}, "geography'POLYGON((0 0, 1 0, 1 1, 0 0))'"},
+	}
+	for _, tt := range tests {
+		got, err := Literal(tt.v)
+		if err != nil {
+			t.Errorf("Literal(%v) error = %v", tt.v, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Literal(%v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package odata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GeoPoint is a geographic point, rendered as geography'POINT(lon lat)'.
+type GeoPoint struct {
+	Longitude float64
+	Latitude  float64
+}
+
+// Point creates a GeoPoint. Note that OData expects longitude first.
+func Point(longitude, latitude float64) GeoPoint {
+	return GeoPoint{Longitude: longitude, Latitude: latitude}
+}
+
+// geoJSONPoint is the GeoJSON form of an Edm.GeographyPoint value in documents.
+type geoJSONPoint struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// MarshalJSON encodes p as a GeoJSON point, the form of Edm.GeographyPoint values in documents.
+func (p GeoPoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(geoJSONPoint{Type: "Point", Coordinates: []float64{p.Longitude, p.Latitude}})
+}
+
+// UnmarshalJSON decodes a GeoJSON point.
+func (p *GeoPoint) UnmarshalJSON(data []byte) error {
+	var g geoJSONPoint
+	if err := json.Unmarshal(data, &g); err != nil {
+		return err
+	}
+	if g.Type != "Point" || len(g.Coordinates) != 2 {
+		return errors.New("invalid GeoJSON point")
+	}
+	p.Longitude, p.Latitude = g.Coordinates[0], g.Coordinates[1]
+	return nil
+}
+
+// Polygon is a geographic polygon, rendered as geography'POLYGON((lon lat, ...))'.
+// Its points must be in counterclockwise order; the ring is closed automatically.
+type Polygon []GeoPoint
+
+// Quote returns s as an OData string literal, doubling single quotes.
+func Quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// Literal formats v as an OData literal. Supported are strings, booleans, integers,
+// floats, time.Time, nil, GeoPoint and Polygon.
+func Literal(v any) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return Quote(v), nil
+	case *string:
+		if v == nil {
+			return "null", nil
+		}
+		return Quote(*v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float32:
+		return formatFloat(float64(v), 32), nil
+	case float64:
+		return formatFloat(v, 64), nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano), nil
+	case GeoPoint:
+		return "geography'POINT(" + formatCoordinates(v) + ")'", nil
+	case Polygon:
+		if len(v) < 3 {
+			return "", fmt.Errorf("polygon needs at least 3 points, got %d", len(v))
+		}
+		ring := v
+		if v[0] != v[len(v)-1] {
+			ring = append(append(Polygon{}, v...), v[0])
+		}
+		coords := make([]string, len(ring))
+		for i, p := range ring {
+			coords[i] = formatCoordinates(p)
+		}
+		return "geography'POLYGON((" + strings.Join(coords, ", ") + "))'", nil
+	}
+	return "", fmt.Errorf("unsupported OData literal type %T", v)
+}
+
+func formatFloat(f float64, bitSize int) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "INF"
+	case math.IsInf(f, -1):
+		return "-INF"
+	}
+	return strconv.FormatFloat(f, 'f', -1, bitSize)
+}
+
+func formatCoordinates(p GeoPoint) string {
+	return formatFloat(p.Longitude, 64) + " " + formatFloat(p.Latitude, 64)
+}
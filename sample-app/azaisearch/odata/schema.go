@@ -0,0 +1,63 @@
+package odata
+
+import (
+	"errors"
+	"fmt"
+
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// RenderFor renders e like Render and validates the fields it references against index.
+// Fields must exist and be filterable; fields of IsMatch must be searchable.
+func RenderFor(e Expr, index *searchservice.SearchIndex) (string, error) {
+	w := &writer{}
+	w.expr(e)
+	if w.err != nil {
+		return "", w.err
+	}
+	if index == nil {
+		return w.sb.String(), nil
+	}
+
+	var errs []error
+	seen := map[fieldRef]bool{}
+	for _, ref := range w.refs {
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		if err := validateRef(index, ref); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return "", err
+	}
+	return w.sb.String(), nil
+}
+
+func validateRef(index *searchservice.SearchIndex, ref fieldRef) error {
+	f, err := schema.FindField(index.Fields, ref.path)
+	if err != nil {
+		return err
+	}
+	if ref.searchable {
+		var elem searchservice.SearchFieldDataType
+		if f.Type != nil {
+			elem, _ = schema.ElementType(*f.Type)
+		}
+		if !schema.Attribute(f.Searchable, elem == searchservice.SearchFieldDataTypeString) {
+			return fmt.Errorf("field %q is not searchable", ref.path)
+		}
+		return nil
+	}
+	// complex fields are only referenced as lambda collections or path prefixes
+	if schema.IsComplex(f) {
+		return nil
+	}
+	if !schema.Attribute(f.Filterable, true) {
+		return fmt.Errorf("field %q is not filterable", ref.path)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package odata
+
+import (
+	"strings"
+	"testing"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+func TestRenderFor(t *testing.T) {
+	str := searchservice.SearchFieldDataTypeString
+	complexType := searchservice.SearchFieldDataTypeComplex
+	no := false
+	index := &searchservice.SearchIndex{Fields: []*searchservice.SearchField{
+		{Name: ptr("category"), Type: &str},
+		{Name: ptr("secret"), Type: &str, Filterable: &no},
+		{Name: ptr("address"), Type: &complexType, Fields: []*searchservice.SearchField{
+			{Name: ptr("city"), Type: &str},
+		}},
+	}}
+	tests := []struct {
+		name    string
+		expr    Expr
+		wantErr string
+	}{
+		{"filterable field", Field("category").Eq("x"), ""},
+		{"subfield", Field("address/city").Eq("x"), ""},
+		{"not filterable", Field("secret").Eq("x"), `field "secret" is not filterable`},
+		{"unknown field", Field("missing").Eq("x"), "missing"},
+		{"all errors", And(Field("secret").Eq("x"), Field("missing").Eq("x")), "secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RenderFor(tt.expr, index)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("RenderFor() error = %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("RenderFor() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,340 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+// Parse parses search text written in the syntax of queryType into a syntax tree. Render
+// of the result produces an equivalent query. Range queries ([a TO b]) are not supported.
+func Parse(text string, queryType searchindex.QueryType) (Node, error) {
+	p := &parser{src: []rune(text), full: queryType == searchindex.QueryTypeFull}
+	p.skipSpace()
+	if p.eof() {
+		return Bool{Op: OpImplicit}, nil
+	}
+	n, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		return nil, p.errorf("unexpected %q", string(p.peek()))
+	}
+	return n, nil
+}
+
+type parser struct {
+	src  []rune
+	pos  int
+	full bool
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("query: position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() rune {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// keyword consumes one of words if it is followed by whitespace or the end of the query.
+func (p *parser) keyword(words ...string) bool {
+	for _, w := range words {
+		end := p.pos + len([]rune(w))
+		if end > len(p.src) || string(p.src[p.pos:end]) != w {
+			continue
+		}
+		if end < len(p.src) && !unicode.IsSpace(p.src[end]) && !strings.ContainsRune("(\"", p.src[end]) {
+			continue
+		}
+		p.pos = end
+		p.skipSpace()
+		return true
+	}
+	return false
+}
+
+func (p *parser) orKeyword() bool {
+	if p.full {
+		return p.keyword("OR", "||")
+	}
+	return p.keyword("|")
+}
+
+func (p *parser) andKeyword() bool {
+	if p.full {
+		return p.keyword("AND", "&&")
+	}
+	return p.keyword("+")
+}
+
+// or := and (OR and)*
+func (p *parser) or() (Node, error) {
+	return p.binary(OpOr, p.orKeyword, p.and)
+}
+
+// and := implicit (AND implicit)*
+func (p *parser) and() (Node, error) {
+	return p.binary(OpAnd, p.andKeyword, p.implicit)
+}
+
+func (p *parser) binary(op Operator, sep func() bool, next func() (Node, error)) (Node, error) {
+	first, err := next()
+	if err != nil {
+		return nil, err
+	}
+	clauses := []Node{first}
+	for sep() {
+		n, err := next()
+		if err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, n)
+	}
+	if len(clauses) == 1 {
+		return first, nil
+	}
+	return Bool{Op: op, Clauses: clauses}, nil
+}
+
+// implicit := clause+
+func (p *parser) implicit() (Node, error) {
+	var clauses []Node
+	for !p.eof() && p.peek() != ')' {
+		save := p.pos
+		if p.orKeyword() || p.andKeyword() {
+			p.pos = save
+			break
+		}
+		n, err := p.clause()
+		if err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, n)
+		p.skipSpace()
+	}
+	switch len(clauses) {
+	case 0:
+		return nil, p.errorf("expected a query clause")
+	case 1:
+		return clauses[0], nil
+	}
+	return Bool{Op: OpImplicit, Clauses: clauses}, nil
+}
+
+// clause := ('+' | '-' | '!' | NOT) clause | (field ':')? primary ('^' number)?
+func (p *parser) clause() (Node, error) {
+	switch {
+	case p.peek() == '+':
+		p.pos++
+		n, err := p.clause()
+		return Required{Query: n}, err
+	case p.peek() == '-' || (p.full && p.peek() == '!'):
+		p.pos++
+		n, err := p.clause()
+		return Not{Query: n}, err
+	case p.full && p.keyword("NOT"):
+		n, err := p.clause()
+		return Not{Query: n}, err
+	}
+
+	n, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	if p.full && p.peek() == '^' {
+		p.pos++
+		f, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		n = Boost{Query: n, Factor: f}
+	}
+	return n, nil
+}
+
+func (p *parser) primary() (Node, error) {
+	switch c := p.peek(); {
+	case c == '(':
+		p.pos++
+		p.skipSpace()
+		n, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, p.errorf("expected ')'")
+		}
+		p.pos++
+		return Group{Query: n}, nil
+	case c == '"':
+		return p.phrase()
+	case p.full && c == '/':
+		return p.regex()
+	}
+
+	text, wildcard, err := p.word()
+	if err != nil {
+		return nil, err
+	}
+	if p.full && p.peek() == ':' && !wildcard {
+		p.pos++
+		q, err := p.primary()
+		if err != nil {
+			return nil, err
+		}
+		return Fielded{Field: text, Query: q}, nil
+	}
+	if p.full && p.peek() == '~' {
+		p.pos++
+		d, err := p.optionalInt()
+		return Fuzzy{Text: text, Distance: d}, err
+	}
+	if wildcard {
+		if t, ok := strings.CutSuffix(text, "*"); ok && !strings.ContainsAny(t, "*?") {
+			return Prefix{Text: t}, nil
+		}
+		if !p.full {
+			return nil, p.errorf("wildcards other than a trailing '*' require the full query syntax")
+		}
+		return Wildcard{Pattern: text}, nil
+	}
+	return Term{Text: text}, nil
+}
+
+// word reads a term, resolving escapes. wildcard reports whether it contains unescaped * or ?.
+func (p *parser) word() (text string, wildcard bool, err error) {
+	special := simpleSpecial
+	if p.full {
+		special = fullSpecial
+	}
+	var sb strings.Builder
+	for !p.eof() {
+		c := p.peek()
+		if unicode.IsSpace(c) {
+			break
+		}
+		if c == '\\' {
+			if p.pos+1 >= len(p.src) {
+				return "", false, p.errorf("dangling escape character")
+			}
+			sb.WriteRune(p.src[p.pos+1])
+			p.pos += 2
+			continue
+		}
+		if c == '*' || (p.full && c == '?') {
+			wildcard = true
+			sb.WriteRune(c)
+			p.pos++
+			continue
+		}
+		// '-' and '+' only start a clause; within a word they are literal
+		if strings.ContainsRune(special, c) && !(sb.Len() > 0 && (c == '-' || c == '+')) {
+			break
+		}
+		sb.WriteRune(c)
+		p.pos++
+	}
+	if sb.Len() == 0 {
+		return "", false, p.errorf("unexpected %q", string(p.peek()))
+	}
+	return sb.String(), wildcard, nil
+}
+
+func (p *parser) phrase() (Node, error) {
+	p.pos++ // opening quote
+	var sb strings.Builder
+	for {
+		if p.eof() {
+			return nil, p.errorf("unterminated phrase")
+		}
+		c := p.peek()
+		p.pos++
+		if c == '\\' && !p.eof() {
+			sb.WriteRune(p.peek())
+			p.pos++
+			continue
+		}
+		if c == '"' {
+			break
+		}
+		sb.WriteRune(c)
+	}
+	ph := Phrase{Text: sb.String()}
+	if p.full && p.peek() == '~' {
+		p.pos++
+		slop, err := p.optionalInt()
+		if err != nil {
+			return nil, err
+		}
+		ph.Slop = slop
+	}
+	return ph, nil
+}
+
+func (p *parser) regex() (Node, error) {
+	p.pos++ // opening slash
+	var sb strings.Builder
+	for {
+		if p.eof() {
+			return nil, p.errorf("unterminated regular expression")
+		}
+		c := p.peek()
+		p.pos++
+		if c == '\\' && !p.eof() && p.peek() == '/' {
+			sb.WriteRune('/')
+			p.pos++
+			continue
+		}
+		if c == '/' {
+			return Regex{Pattern: sb.String()}, nil
+		}
+		sb.WriteRune(c)
+	}
+}
+
+func (p *parser) digits() string {
+	start := p.pos
+	for !p.eof() && (unicode.IsDigit(p.peek()) || p.peek() == '.') {
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+func (p *parser) optionalInt() (int, error) {
+	s := p.digits()
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, p.errorf("invalid number %q", s)
+	}
+	return n, nil
+}
+
+func (p *parser) number() (float64, error) {
+	s := p.digits()
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, p.errorf("invalid number %q", s)
+	}
+	return f, nil
+}
@@ -0,0 +1,72 @@
+package query
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		node      Node
+		queryType searchindex.QueryType
+	}{
+		{"term", Term{"pool"}, searchindex.QueryTypeFull},
+		{"whitespace", Term{"new york"}, searchindex.QueryTypeFull},
+		{"special", Term{`a:b(c)"d\e`}, searchindex.QueryTypeFull},
+		{"keyword AND", Term{"AND"}, searchindex.QueryTypeFull},
+		{"keyword OR", Term{"OR"}, searchindex.QueryTypeFull},
+		{"keyword NOT", Term{"NOT"}, searchindex.QueryTypeFull},
+		{"double operators", Term{"a && b || c"}, searchindex.QueryTypeFull},
+		{"fielded injection", Fielded{"title", Term{"x OR secret:y"}}, searchindex.QueryTypeFull},
+		{"fielded keyword", Fielded{"title", Term{"NOT"}}, searchindex.QueryTypeFull},
+		{"prefix", Prefix{"new yo"}, searchindex.QueryTypeFull},
+		{"wildcard", Wildcard{"h?tel *x"}, searchindex.QueryTypeFull},
+		{"fuzzy", Fuzzy{Text: "a b", Distance: 1}, searchindex.QueryTypeFull},
+		{"phrase", Phrase{Text: `say "hi"`, Slop: 3}, searchindex.QueryTypeFull},
+		{"boost", Boost{Term{"a b"}, 2}, searchindex.QueryTypeFull},
+		{"bool", And(Term{"OR"}, Group{Or(Term{"a"}, Not{Term{"NOT"}})}), searchindex.QueryTypeFull},
+		{"simple term", Term{"a | -b"}, searchindex.QueryTypeSimple},
+		{"simple operators", Term{"a+b|c-d"}, searchindex.QueryTypeSimple},
+		{"simple bool", Or(Term{"x + y"}, Required{Term{"z"}}), searchindex.QueryTypeSimple},
+		{"simple keyword", Bool{Clauses: []Node{Term{"NOT"}, Term{"OR"}}}, searchindex.QueryTypeSimple},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := Render(tt.node, tt.queryType)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			got, err := Parse(text, tt.queryType)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", text, err)
+			}
+			if !reflect.DeepEqual(got, tt.node) {
+				t.Errorf("Parse(%q) = %#v, want %#v", text, got, tt.node)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"unclosed group", "(a OR b", "expected ')'"},
+		{"dangling escape", `a\`, "dangling escape character"},
+		{"bare NOT", "NOT", "unexpected"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.text, searchindex.QueryTypeFull)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse(%q) error = %v, want %q", tt.text, err, tt.want)
+			}
+		})
+	}
+}
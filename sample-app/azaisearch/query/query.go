@@ -0,0 +1,312 @@
+// Package query builds search text for the simple and full (Lucene) query syntaxes of Azure AI Search,
+// e.g. for SearchRequest.SearchText with the matching SearchRequest.QueryType.
+//
+//	q := query.And(
+//		query.Fielded{Field: "title", Query: query.Phrase{Text: userInput}},
+//		query.Boost{Query: query.Fuzzy{Text: "hotel"}, Factor: 2},
+//	)
+//	text, err := query.Render(q, searchindex.QueryTypeFull) // title:"..." AND hotel~^2
+//
+// Text in Term, Prefix, Fuzzy and Phrase nodes is escaped for the target syntax, including
+// whitespace and operator keywords, so user input can be used safely as a single term. Parse turns an existing query back into nodes so it can be rewritten.
+package query
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+// Node is a node of a query's syntax tree.
+type Node interface {
+	render(r *renderer)
+}
+
+// Term matches a single term.
+type Term struct {
+	Text string
+}
+
+// Prefix matches terms starting with Text (Text*).
+type Prefix struct {
+	Text string
+}
+
+// Wildcard matches terms against Pattern, where * matches any sequence of characters and ? a single character.
+// Other special characters in Pattern are escaped. Full syntax only.
+type Wildcard struct {
+	Pattern string
+}
+
+// Fuzzy matches terms similar to Text within Distance edits (Text~Distance); zero uses the service default
+// of 2. Full syntax only.
+type Fuzzy struct {
+	Text     string
+	Distance int
+}
+
+// Phrase matches Text as a phrase. With a positive Slop, the terms may be up to Slop positions apart
+// (proximity search, "Text"~Slop); Slop requires the full syntax.
+type Phrase struct {
+	Text string
+	Slop int
+}
+
+// Regex matches terms against the regular expression Pattern (/Pattern/). Full syntax only.
+type Regex struct {
+	Pattern string
+}
+
+// Fielded scopes Query to Field (Field:Query). Full syntax only.
+type Fielded struct {
+	Field string
+	Query Node
+}
+
+// Boost weights Query by Factor (Query^Factor). Full syntax only.
+type Boost struct {
+	Query  Node
+	Factor float64
+}
+
+// Group wraps Query in parentheses.
+type Group struct {
+	Query Node
+}
+
+// Required requires Query to match (+Query).
+type Required struct {
+	Query Node
+}
+
+// Not excludes documents matching Query (-Query).
+type Not struct {
+	Query Node
+}
+
+// Operator combines the clauses of a Bool.
+type Operator int
+
+const (
+	// OpImplicit joins clauses with whitespace; the searchMode decides whether any or all must match.
+	OpImplicit Operator = iota
+	// OpAnd requires all clauses to match.
+	OpAnd
+	// OpOr requires any clause to match.
+	OpOr
+)
+
+// Bool combines Clauses with Op.
+type Bool struct {
+	Op      Operator
+	Clauses []Node
+}
+
+// And requires all of nodes to match.
+func And(nodes ...Node) Bool { return Bool{Op: OpAnd, Clauses: nodes} }
+
+// Or requires any of nodes to match.
+func Or(nodes ...Node) Bool { return Bool{Op: OpOr, Clauses: nodes} }
+
+// Raw is query text that is rendered verbatim. It is not escaped; never build it from user input.
+type Raw string
+
+// fullSpecial are the characters with a special meaning in the full Lucene syntax.
+const fullSpecial = `+-&|!(){}[]^"~*?:\/`
+
+// simpleSpecial are the characters with a special meaning in the simple syntax.
+const simpleSpecial = `+|-"*()\`
+
+// fullKeywords are the operators of the full syntax that are written as words.
+var fullKeywords = []string{"AND", "OR", "NOT"}
+
+// Escape escapes text so that it is matched as a single term in the given query syntax: special
+// characters and whitespace are escaped with a backslash, and so is the first letter of a term
+// that is an operator keyword (AND, OR, NOT) of the full syntax. Unknown syntaxes, including
+// semantic, are escaped like simple.
+func Escape(text string, queryType searchindex.QueryType) string {
+	return escape(text, queryType == searchindex.QueryTypeFull, "")
+}
+
+// escape escapes text for the full or simple syntax, except for the characters in keep.
+func escape(text string, full bool, keep string) string {
+	special := simpleSpecial
+	if full {
+		special = fullSpecial
+	}
+	var sb strings.Builder
+	if full && slices.Contains(fullKeywords, text) {
+		sb.WriteByte('\\')
+	}
+	for _, c := range text {
+		if (strings.ContainsRune(special, c) || unicode.IsSpace(c)) && !strings.ContainsRune(keep, c) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// Render renders n as search text for queryType. It returns an error if n uses features that
+// queryType doesn't support. Semantic queries use the simple syntax.
+func Render(n Node, queryType searchindex.QueryType) (string, error) {
+	r := &renderer{full: queryType == searchindex.QueryTypeFull}
+	r.node(n)
+	if r.err != nil {
+		return "", r.err
+	}
+	return r.sb.String(), nil
+}
+
+// String renders n in the full syntax, ignoring errors.
+func String(n Node) string {
+	s, _ := Render(n, searchindex.QueryTypeFull)
+	return s
+}
+
+type renderer struct {
+	sb   strings.Builder
+	full bool
+	err  error
+}
+
+func (r *renderer) str(s string) {
+	r.sb.WriteString(s)
+}
+
+// text writes s escaped as a single term.
+func (r *renderer) text(s string) {
+	if s == "" && r.err == nil {
+		r.err = fmt.Errorf("empty term")
+	}
+	r.str(escape(s, r.full, ""))
+}
+
+// requireFull records an error if the renderer targets the simple syntax.
+func (r *renderer) requireFull(feature string) {
+	if !r.full && r.err == nil {
+		r.err = fmt.Errorf("%s requires the full query syntax", feature)
+	}
+}
+
+func (r *renderer) node(n Node) {
+	if n == nil {
+		if r.err == nil {
+			r.err = fmt.Errorf("nil query node")
+		}
+		return
+	}
+	n.render(r)
+}
+
+// operand renders n, wrapping boolean expressions in parentheses.
+func (r *renderer) operand(n Node) {
+	if _, ok := n.(Bool); ok {
+		r.str("(")
+		r.node(n)
+		r.str(")")
+		return
+	}
+	r.node(n)
+}
+
+func (t Term) render(r *renderer) {
+	r.text(t.Text)
+}
+
+func (p Prefix) render(r *renderer) {
+	r.text(p.Text)
+	r.str("*")
+}
+
+func (w Wildcard) render(r *renderer) {
+	r.requireFull("wildcard search")
+	if w.Pattern == "" && r.err == nil {
+		r.err = fmt.Errorf("empty wildcard pattern")
+	}
+	r.str(escape(w.Pattern, true, "*?"))
+}
+
+func (f Fuzzy) render(r *renderer) {
+	r.requireFull("fuzzy search")
+	r.text(f.Text)
+	r.str("~")
+	if f.Distance > 0 {
+		r.str(strconv.Itoa(f.Distance))
+	}
+}
+
+func (p Phrase) render(r *renderer) {
+	r.str(`"` + strings.ReplaceAll(strings.ReplaceAll(p.Text, `\`, `\\`), `"`, `\"`) + `"`)
+	if p.Slop > 0 {
+		r.requireFull("proximity search")
+		r.str("~" + strconv.Itoa(p.Slop))
+	}
+}
+
+func (x Regex) render(r *renderer) {
+	r.requireFull("regular expression search")
+	r.str("/" + strings.ReplaceAll(x.Pattern, "/", `\/`) + "/")
+}
+
+func (f Fielded) render(r *renderer) {
+	r.requireFull("fielded search")
+	r.str(f.Field + ":")
+	r.operand(f.Query)
+}
+
+func (b Boost) render(r *renderer) {
+	r.requireFull("term boosting")
+	r.operand(b.Query)
+	r.str("^" + strconv.FormatFloat(b.Factor, 'f', -1, 64))
+}
+
+func (g Group) render(r *renderer) {
+	r.str("(")
+	r.node(g.Query)
+	r.str(")")
+}
+
+func (q Required) render(r *renderer) {
+	r.str("+")
+	r.operand(q.Query)
+}
+
+func (n Not) render(r *renderer) {
+	r.str("-")
+	r.operand(n.Query)
+}
+
+func (b Bool) render(r *renderer) {
+	sep := " "
+	switch b.Op {
+	case OpAnd:
+		sep = " + "
+		if r.full {
+			sep = " AND "
+		}
+	case OpOr:
+		sep = " | "
+		if r.full {
+			sep = " OR "
+		}
+	}
+	for i, c := range b.Clauses {
+		if i > 0 {
+			r.str(sep)
+		}
+		if inner, ok := c.(Bool); ok && inner.Op == b.Op {
+			r.node(inner)
+			continue
+		}
+		r.operand(c)
+	}
+}
+
+func (q Raw) render(r *renderer) {
+	r.str(string(q))
+}
@@ -0,0 +1,105 @@
+package query
+
+import (
+	"strings"
+	"testing"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		queryType searchindex.QueryType
+		want      string
+	}{
+		{"plain", "hotel", searchindex.QueryTypeFull, "hotel"},
+		{"full special", "a:b(c)", searchindex.QueryTypeFull, `a\:b\(c\)`},
+		{"full operators", "a&&b||c", searchindex.QueryTypeFull, `a\&\&b\|\|c`},
+		{"whitespace", "new york", searchindex.QueryTypeFull, `new\ york`},
+		{"tab", "a\tb", searchindex.QueryTypeFull, "a\\\tb"},
+		{"keyword AND", "AND", searchindex.QueryTypeFull, `\AND`},
+		{"keyword OR", "OR", searchindex.QueryTypeFull, `\OR`},
+		{"keyword NOT", "NOT", searchindex.QueryTypeFull, `\NOT`},
+		{"lower-case keyword", "not", searchindex.QueryTypeFull, "not"},
+		{"keyword in simple", "NOT", searchindex.QueryTypeSimple, "NOT"},
+		{"simple operators", "a+b|c-d", searchindex.QueryTypeSimple, `a\+b\|c\-d`},
+		{"simple whitespace", "a | b", searchindex.QueryTypeSimple, `a\ \|\ b`},
+		{"simple keeps colon", "a:b", searchindex.QueryTypeSimple, "a:b"},
+		{"semantic like simple", "-a", searchindex.QueryTypeSemantic, `\-a`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Escape(tt.text, tt.queryType); got != tt.want {
+				t.Errorf("Escape() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name      string
+		node      Node
+		queryType searchindex.QueryType
+		want      string
+	}{
+		{"term", Term{"pool"}, searchindex.QueryTypeSimple, "pool"},
+		{"prefix", Prefix{"lux"}, searchindex.QueryTypeSimple, "lux*"},
+		{"wildcard", Wildcard{"ho?el*"}, searchindex.QueryTypeFull, "ho?el*"},
+		{"wildcard escapes", Wildcard{"a b:*"}, searchindex.QueryTypeFull, `a\ b\:*`},
+		{"fuzzy", Fuzzy{Text: "hotle", Distance: 1}, searchindex.QueryTypeFull, "hotle~1"},
+		{"phrase", Phrase{Text: `say "hi"`}, searchindex.QueryTypeSimple, `"say \"hi\""`},
+		{"proximity", Phrase{Text: "hotel airport", Slop: 5}, searchindex.QueryTypeFull, `"hotel airport"~5`},
+		{"regex", Regex{"a/b.*"}, searchindex.QueryTypeFull, `/a\/b.*/`},
+		{"fielded", Fielded{"title", Term{"pool"}}, searchindex.QueryTypeFull, "title:pool"},
+		{"fielded injection", Fielded{"title", Term{"x OR secret:y"}}, searchindex.QueryTypeFull, `title:x\ OR\ secret\:y`},
+		{"fielded bool", Fielded{"title", Or(Term{"a"}, Term{"b"})}, searchindex.QueryTypeFull, "title:(a OR b)"},
+		{"boost", Boost{Term{"pool"}, 2.5}, searchindex.QueryTypeFull, "pool^2.5"},
+		{"keyword term", Term{"NOT"}, searchindex.QueryTypeFull, `\NOT`},
+		{"and full", And(Term{"a"}, Term{"b"}), searchindex.QueryTypeFull, "a AND b"},
+		{"and simple", And(Term{"a"}, Term{"b"}), searchindex.QueryTypeSimple, "a + b"},
+		{"or simple", Or(Term{"a"}, Term{"b"}), searchindex.QueryTypeSimple, "a | b"},
+		{"simple injection", And(Term{"a | -b"}, Term{"c"}), searchindex.QueryTypeSimple, `a\ \|\ \-b + c`},
+		{"implicit", Bool{Clauses: []Node{Term{"a"}, Not{Term{"b"}}}}, searchindex.QueryTypeSimple, "a -b"},
+		{"nested", And(Term{"a"}, Or(Term{"b"}, Term{"c"})), searchindex.QueryTypeFull, "a AND (b OR c)"},
+		{"flattened", And(Term{"a"}, And(Term{"b"}, Term{"c"})), searchindex.QueryTypeFull, "a AND b AND c"},
+		{"required", Required{Term{"a"}}, searchindex.QueryTypeSimple, "+a"},
+		{"raw", Raw("a:(b OR c)"), searchindex.QueryTypeFull, "a:(b OR c)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.node, tt.queryType)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		node Node
+		want string
+	}{
+		{"nil", nil, "nil query node"},
+		{"empty term", Term{}, "empty term"},
+		{"empty wildcard", Wildcard{}, "requires the full query syntax"},
+		{"fielded in simple", Fielded{"title", Term{"a"}}, "fielded search requires the full query syntax"},
+		{"fuzzy in simple", Fuzzy{Text: "a"}, "fuzzy search requires the full query syntax"},
+		{"proximity in simple", Phrase{Text: "a b", Slop: 2}, "proximity search requires the full query syntax"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Render(tt.node, searchindex.QueryTypeSimple)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Render() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package azaisearch
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+type SearchClientOptions struct {
+	azcore.ClientOptions
+
+	// Audience overrides the token audience derived from ClientOptions.Cloud, e.g. for
+	// sovereign or private clouds. Setting it also disables endpoint validation.
+	Audience string
+}
+
+// NewSearchClient creates a new instance of SearchClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - client options, pass nil to accept the default values.
+func NewSearchClient(endpoint string, cred azcore.TokenCredential, options *SearchClientOptions) (*searchservice.SearchClient, error) {
+	if options == nil {
+		options = &SearchClientOptions{}
+	}
+
+	authPolicy, err := newBearerTokenPolicy(endpoint, cred, options.Cloud, options.Audience)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchClient(endpoint, authPolicy, options)
+}
+
+// NewSearchClientWithSharedKey creates a new instance of SearchClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - keyCred - used to authorize requests with a shared key
+//   - options - client options, pass nil to accept the default values.
+func NewSearchClientWithSharedKey(endpoint string, keyCred *azcore.KeyCredential, options *SearchClientOptions) (*searchservice.SearchClient, error) {
+
+	authPolicy := runtime.NewKeyCredentialPolicy(keyCred, "api-key", &runtime.KeyCredentialPolicyOptions{})
+	return newSearchClient(endpoint, authPolicy, options)
+}
+
+func newSearchClient(endpoint string, authPolicy policy.Policy, options *SearchClientOptions) (*searchservice.SearchClient, error) {
+	if options == nil {
+		options = &SearchClientOptions{}
+	}
+
+	c, err := newCoreClient(authPolicy, &options.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchservice.NewSearchClient(endpoint, c)
+}
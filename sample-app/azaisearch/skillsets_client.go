@@ -0,0 +1,56 @@
+package azaisearch
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+type SkillsetsClientOptions struct {
+	azcore.ClientOptions
+
+	// Audience overrides the token audience derived from ClientOptions.Cloud, e.g. for
+	// sovereign or private clouds. Setting it also disables endpoint validation.
+	Audience string
+}
+
+// NewSkillsetsClient creates a new instance of SkillsetsClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - client options, pass nil to accept the default values.
+func NewSkillsetsClient(endpoint string, cred azcore.TokenCredential, options *SkillsetsClientOptions) (*searchservice.SkillsetsClient, error) {
+	if options == nil {
+		options = &SkillsetsClientOptions{}
+	}
+
+	authPolicy, err := newBearerTokenPolicy(endpoint, cred, options.Cloud, options.Audience)
+	if err != nil {
+		return nil, err
+	}
+	return newSkillsetsClient(endpoint, authPolicy, options)
+}
+
+// NewSkillsetsClientWithSharedKey creates a new instance of SkillsetsClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - keyCred - used to authorize requests with a shared key
+//   - options - client options, pass nil to accept the default values.
+func NewSkillsetsClientWithSharedKey(endpoint string, keyCred *azcore.KeyCredential, options *SkillsetsClientOptions) (*searchservice.SkillsetsClient, error) {
+
+	authPolicy := runtime.NewKeyCredentialPolicy(keyCred, "api-key", &runtime.KeyCredentialPolicyOptions{})
+	return newSkillsetsClient(endpoint, authPolicy, options)
+}
+
+func newSkillsetsClient(endpoint string, authPolicy policy.Policy, options *SkillsetsClientOptions) (*searchservice.SkillsetsClient, error) {
+	if options == nil {
+		options = &SkillsetsClientOptions{}
+	}
+
+	c, err := newCoreClient(authPolicy, &options.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchservice.NewSkillsetsClient(endpoint, c)
+}
@@ -0,0 +1,56 @@
+package azaisearch
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+type SynonymMapsClientOptions struct {
+	azcore.ClientOptions
+
+	// Audience overrides the token audience derived from ClientOptions.Cloud, e.g. for
+	// sovereign or private clouds. Setting it also disables endpoint validation.
+	Audience string
+}
+
+// NewSynonymMapsClient creates a new instance of SynonymMapsClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - client options, pass nil to accept the default values.
+func NewSynonymMapsClient(endpoint string, cred azcore.TokenCredential, options *SynonymMapsClientOptions) (*searchservice.SynonymMapsClient, error) {
+	if options == nil {
+		options = &SynonymMapsClientOptions{}
+	}
+
+	authPolicy, err := newBearerTokenPolicy(endpoint, cred, options.Cloud, options.Audience)
+	if err != nil {
+		return nil, err
+	}
+	return newSynonymMapsClient(endpoint, authPolicy, options)
+}
+
+// NewSynonymMapsClientWithSharedKey creates a new instance of SynonymMapsClient with the specified values.
+//   - endpoint - the endpoint of the Azure AI Search service
+//   - keyCred - used to authorize requests with a shared key
+//   - options - client options, pass nil to accept the default values.
+func NewSynonymMapsClientWithSharedKey(endpoint string, keyCred *azcore.KeyCredential, options *SynonymMapsClientOptions) (*searchservice.SynonymMapsClient, error) {
+
+	authPolicy := runtime.NewKeyCredentialPolicy(keyCred, "api-key", &runtime.KeyCredentialPolicyOptions{})
+	return newSynonymMapsClient(endpoint, authPolicy, options)
+}
+
+func newSynonymMapsClient(endpoint string, authPolicy policy.Policy, options *SynonymMapsClientOptions) (*searchservice.SynonymMapsClient, error) {
+	if options == nil {
+		options = &SynonymMapsClientOptions{}
+	}
+
+	c, err := newCoreClient(authPolicy, &options.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchservice.NewSynonymMapsClient(endpoint, c)
+}
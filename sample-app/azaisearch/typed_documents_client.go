@@ -0,0 +1,188 @@
+package azaisearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+// TypedDocumentsClient wraps a DocumentsClient and maps index documents to and from
+// values of the Go type T. Index field names are taken from the json and search
+// struct tags of T (see documentFields).
+type TypedDocumentsClient[T any] struct {
+	docs *searchindex.DocumentsClient
+}
+
+// TypedResult is a search result whose document has been decoded into T.
+type TypedResult[T any] struct {
+	// Document is the matching document.
+	Document T
+
+	// Score is the relevance score of the document compared to other documents returned by the query.
+	Score float64
+
+	// RerankerScore is the relevance score computed by the semantic ranker, if any.
+	RerankerScore *float64
+
+	// Highlights are text fragments from the document that indicate the matching search terms, keyed by field name.
+	Highlights map[string][]string
+
+	// Captions are the most representative passages from the document, when using semantic search.
+	Captions []*searchindex.QueryCaptionResult
+}
+
+// NewTypedDocumentsClient creates a new instance of TypedDocumentsClient for documents of type T.
+//   - docs - the DocumentsClient of the index, e.g. from Client.Documents
+func NewTypedDocumentsClient[T any](docs *searchindex.DocumentsClient) *TypedDocumentsClient[T] {
+	return &TypedDocumentsClient[T]{docs: docs}
+}
+
+// DocumentsClient returns the underlying untyped DocumentsClient.
+func (c *TypedDocumentsClient[T]) DocumentsClient() *searchindex.DocumentsClient {
+	return c.docs
+}
+
+// Upload uploads docs to the index, replacing documents with the same key.
+func (c *TypedDocumentsClient[T]) Upload(ctx context.Context, docs []T) (searchindex.IndexDocumentsResult, error) {
+	return c.index(ctx, searchindex.IndexActionTypeUpload, docs)
+}
+
+// Merge merges docs into existing documents with the same key.
+func (c *TypedDocumentsClient[T]) Merge(ctx context.Context, docs []T) (searchindex.IndexDocumentsResult, error) {
+	return c.index(ctx, searchindex.IndexActionTypeMerge, docs)
+}
+
+// MergeOrUpload merges docs into existing documents, or uploads them if they don't exist yet.
+func (c *TypedDocumentsClient[T]) MergeOrUpload(ctx context.Context, docs []T) (searchindex.IndexDocumentsResult, error) {
+	return c.index(ctx, searchindex.IndexActionTypeMergeOrUpload, docs)
+}
+
+// Delete deletes the documents with the keys of docs. Fields other than the key are ignored by the service.
+func (c *TypedDocumentsClient[T]) Delete(ctx context.Context, docs []T) (searchindex.IndexDocumentsResult, error) {
+	return c.index(ctx, searchindex.IndexActionTypeDelete, docs)
+}
+
+func (c *TypedDocumentsClient[T]) index(ctx context.Context, actionType searchindex.IndexActionType, docs []T) (searchindex.IndexDocumentsResult, error) {
+	batch, err := NewIndexBatch(actionType, docs)
+	if err != nil {
+		return searchindex.IndexDocumentsResult{}, err
+	}
+	resp, err := c.docs.Index(ctx, batch, nil, nil)
+	if err != nil {
+		return searchindex.IndexDocumentsResult{}, err
+	}
+	return resp.IndexDocumentsResult, nil
+}
+
+// Get retrieves the document with the given key. The document is decoded from the response body,
+// so Edm.Int64 values beyond 2^53 aren't rounded.
+//   - options - DocumentsClientGetOptions contains the optional parameters, pass nil to retrieve all retrievable fields.
+func (c *TypedDocumentsClient[T]) Get(ctx context.Context, key string, options *searchindex.DocumentsClientGetOptions) (T, error) {
+	var doc T
+	var httpResp *http.Response
+	if _, err := c.docs.Get(policy.WithCaptureResponse(ctx, &httpResp), key, options, nil); err != nil {
+		return doc, err
+	}
+	body, err := runtime.Payload(httpResp)
+	if err != nil {
+		return doc, err
+	}
+	var fields map[string]any
+	if err := unmarshalUseNumber(body, &fields); err != nil {
+		return doc, fmt.Errorf("decoding document: %w", err)
+	}
+	if err := decodeDocument(fields, &doc); err != nil {
+		return doc, err
+	}
+	return doc, nil
+}
+
+// Search runs searchRequest against the index with GET or POST, see DocumentsClient.Search, and decodes the results of the first page into T.
+func (c *TypedDocumentsClient[T]) Search(ctx context.Context, searchRequest searchindex.SearchRequest) ([]TypedResult[T], error) {
+	var httpResp *http.Response
+	if _, err := c.docs.Search(policy.WithCaptureResponse(ctx, &httpResp), searchRequest, nil, nil); err != nil {
+		return nil, err
+	}
+	body, err := runtime.Payload(httpResp)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeSearchResults[T](body)
+}
+
+// NewIndexBatch creates an IndexBatch that applies actionType to each of docs.
+func NewIndexBatch[T any](actionType searchindex.IndexActionType, docs []T) (searchindex.IndexBatch, error) {
+	batch := searchindex.IndexBatch{Actions: make([]*searchindex.IndexAction, 0, len(docs))}
+	for i := range docs {
+		props, err := encodeDocument(docs[i])
+		if err != nil {
+			return searchindex.IndexBatch{}, fmt.Errorf("document %d: %w", i, err)
+		}
+		batch.Actions = append(batch.Actions, &searchindex.IndexAction{
+			ActionType:           &actionType,
+			AdditionalProperties: props,
+		})
+	}
+	return batch, nil
+}
+
+// DecodeSearchResults decodes the results in the body of a search response into T. Documents are
+// decoded from the body rather than from the SearchResult values of a DocumentsClient response,
+// whose numbers have already been unmarshalled to float64, so Edm.Int64 values beyond 2^53 aren't
+// rounded. Pass policy.WithCaptureResponse to the search call and use runtime.Payload to get the body.
+func DecodeSearchResults[T any](body []byte) ([]TypedResult[T], error) {
+	var page struct {
+		Value []json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, fmt.Errorf("unmarshalling search results: %w", err)
+	}
+	typed := make([]TypedResult[T], 0, len(page.Value))
+	for i, raw := range page.Value {
+		var fields map[string]any
+		if err := unmarshalUseNumber(raw, &fields); err != nil {
+			return nil, fmt.Errorf("result %d: %w", i, err)
+		}
+		if fields == nil {
+			continue
+		}
+		var r searchindex.SearchResult
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return nil, fmt.Errorf("result %d: %w", i, err)
+		}
+		for k := range fields {
+			if strings.HasPrefix(k, "@search.") {
+				delete(fields, k)
+			}
+		}
+		tr := TypedResult[T]{
+			RerankerScore: r.RerankerScore,
+			Captions:      r.Captions,
+		}
+		if r.Score != nil {
+			tr.Score = *r.Score
+		}
+		if len(r.Highlights) > 0 {
+			tr.Highlights = make(map[string][]string, len(r.Highlights))
+			for field, fragments := range r.Highlights {
+				for _, f := range fragments {
+					if f != nil {
+						tr.Highlights[field] = append(tr.Highlights[field], *f)
+					}
+				}
+			}
+		}
+		if err := decodeDocument(fields, &tr.Document); err != nil {
+			return nil, fmt.Errorf("result %d: %w", i, err)
+		}
+		typed = append(typed, tr)
+	}
+	return typed, nil
+}
@@ -0,0 +1,88 @@
+package azaisearch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+)
+
+// transportFunc is a policy.Transporter that answers requests with a function.
+type transportFunc func(*http.Request) (*http.Response, error)
+
+func (f transportFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// jsonResponse returns a response to req with the given status code and JSON body.
+func jsonResponse(req *http.Request, status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// newTestDocumentsClient returns a DocumentsClient of the hotels index that sends its requests to transport.
+func newTestDocumentsClient(t *testing.T, transport policy.Transporter) *searchindex.DocumentsClient {
+	t.Helper()
+	docs, err := NewDocumentsClientWithSharedKey("https://test.search.windows.net", "hotels", azcore.NewKeyCredential("key"), &DocumentClientOptions{
+		ClientOptions: azcore.ClientOptions{Transport: transport, Retry: policy.RetryOptions{MaxRetries: -1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return docs
+}
+
+func TestTypedDocumentsClientInt64RoundTrip(t *testing.T) {
+	type hotel struct {
+		ID    string `json:"id" search:"key"`
+		Views int64  `json:"views" search:"name=viewCount"`
+	}
+	// 2^53 + 1 is the smallest integer a float64 can't represent
+	const views = int64(1<<53 + 1)
+	docs := newTestDocumentsClient(t, transportFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/docs('1')"):
+			return jsonResponse(req, http.StatusOK, `{"id":"1","viewCount":9007199254740993}`), nil
+		case strings.HasSuffix(req.URL.Path, "/docs"), strings.HasSuffix(req.URL.Path, "/docs/search.post.search"):
+			return jsonResponse(req, http.StatusOK, `{"value":[{"@search.score":1.5,"@search.highlights":{"id":["<em>1</em>"]},"id":"1","viewCount":9007199254740993}]}`), nil
+		}
+		t.Errorf("unexpected request %s %s", req.Method, req.URL)
+		return jsonResponse(req, http.StatusNotFound, `{}`), nil
+	}))
+	client := NewTypedDocumentsClient[hotel](docs)
+
+	got, err := client.Get(context.Background(), "1", nil)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if want := (hotel{ID: "1", Views: views}); got != want {
+		t.Errorf("Get() = %+v, want %+v", got, want)
+	}
+
+	results, err := client.Search(context.Background(), searchindex.SearchRequest{SearchText: ptr("*")})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Search() returned %d results, want 1", len(results))
+	}
+	if want := (hotel{ID: "1", Views: views}); results[0].Document != want {
+		t.Errorf("Search() document = %+v, want %+v", results[0].Document, want)
+	}
+	if results[0].Score != 1.5 {
+		t.Errorf("Search() score = %v, want 1.5", results[0].Score)
+	}
+	if got := results[0].Highlights["id"]; len(got) != 1 || got[0] != "<em>1</em>" {
+		t.Errorf("Search() highlights = %v, want [<em>1</em>]", got)
+	}
+}
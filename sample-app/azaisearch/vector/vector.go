@@ -0,0 +1,296 @@
+// Package vector builds vector and hybrid search requests, filling SearchRequest.VectorQueries
+// from []float32 embeddings or text that the index vectorizes.
+//
+//	req, err := vector.NewRequest(
+//		vector.Vector(embedding).Fields("contentVector").K(50),
+//		vector.Text("walking distance to the beach").Fields("descriptionVector").Weight(0.5),
+//	).SearchText("beach hotel").Filter("rating ge 4").FilterMode(searchindex.VectorFilterModePreFilter).BuildFor(index)
+//
+// Without search text the request is a pure vector query; with search text it is a hybrid query
+// whose text and vector results are merged with Reciprocal Rank Fusion.
+package vector
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"sample-app/azaisearch/internal/schema"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+// Query is a single vector query. Its methods return modified copies.
+type Query struct {
+	kind         searchindex.VectorQueryKind
+	vector       []float32
+	text         string
+	fields       []string
+	k            *int32
+	weight       *float32
+	exhaustive   *bool
+	oversampling *float64
+}
+
+// Vector creates a query for the nearest neighbors of the embedding v.
+func Vector(v []float32) Query {
+	return Query{kind: searchindex.VectorQueryKindVector, vector: v}
+}
+
+// Text creates a query for the nearest neighbors of text, which the service vectorizes with the
+// vectorizer of the searched fields' vector search profile.
+func Text(text string) Query {
+	return Query{kind: searchindex.VectorQueryKindText, text: text}
+}
+
+// Fields sets the vector fields to search. Searching multiple fields runs the query on each of them.
+func (q Query) Fields(fields ...string) Query {
+	q.fields = append([]string(nil), fields...)
+	return q
+}
+
+// K sets the number of nearest neighbors to return.
+func (q Query) K(k int32) Query {
+	q.k = &k
+	return q
+}
+
+// Weight sets the relative weight of the query when its results are merged with those of the
+// text query and other vector queries. The service default is 1.
+func (q Query) Weight(w float32) Query {
+	q.weight = &w
+	return q
+}
+
+// Exhaustive sets whether to search all vectors instead of the approximate nearest neighbor index.
+func (q Query) Exhaustive(exhaustive bool) Query {
+	q.exhaustive = &exhaustive
+	return q
+}
+
+// Oversampling sets the oversampling factor of fields with a compression method, overriding the
+// index's defaultOversampling. It must be at least 1.
+func (q Query) Oversampling(factor float64) Query {
+	q.oversampling = &factor
+	return q
+}
+
+// validate checks q independently of an index.
+func (q Query) validate() error {
+	switch {
+	case q.kind == searchindex.VectorQueryKindVector && len(q.vector) == 0:
+		return errors.New("vector is empty")
+	case q.kind == searchindex.VectorQueryKindText && strings.TrimSpace(q.text) == "":
+		return errors.New("text is empty")
+	case len(q.fields) == 0:
+		return errors.New("no fields to search")
+	case q.k != nil && *q.k <= 0:
+		return fmt.Errorf("k must be positive, got %d", *q.k)
+	case q.weight != nil && *q.weight <= 0:
+		return fmt.Errorf("weight must be positive, got %g", *q.weight)
+	case q.oversampling != nil && *q.oversampling < 1:
+		return fmt.Errorf("oversampling must be at least 1, got %g", *q.oversampling)
+	}
+	return nil
+}
+
+// validateFor checks that the fields of q are vector fields of index matching the query.
+func (q Query) validateFor(index *searchservice.SearchIndex) error {
+	var errs []error
+	for _, name := range q.fields {
+		f, err := schema.FindField(index.Fields, name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if f.Type == nil || f.VectorSearchDimensions == nil {
+			errs = append(errs, fmt.Errorf("field %q is not a vector field", name))
+			continue
+		}
+		if _, collection := schema.ElementType(*f.Type); !collection {
+			errs = append(errs, fmt.Errorf("field %q is not a vector field", name))
+			continue
+		}
+		switch q.kind {
+		case searchindex.VectorQueryKindVector:
+			if dims := int(*f.VectorSearchDimensions); len(q.vector) != dims {
+				errs = append(errs, fmt.Errorf("field %q has %d dimensions, but the vector has %d", name, dims, len(q.vector)))
+			}
+		case searchindex.VectorQueryKindText:
+			if !hasVectorizer(index, f) {
+				errs = append(errs, fmt.Errorf("field %q has no vectorizer to vectorize text queries", name))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// hasVectorizer reports whether the vector search profile of f has a vectorizer.
+func hasVectorizer(index *searchservice.SearchIndex, f *searchservice.SearchField) bool {
+	if f.VectorSearchProfileName == nil || index.VectorSearch == nil {
+		return false
+	}
+	for _, p := range index.VectorSearch.Profiles {
+		if p != nil && p.Name != nil && *p.Name == *f.VectorSearchProfileName {
+			return p.VectorizerName != nil && *p.VectorizerName != ""
+		}
+	}
+	return false
+}
+
+// classification returns q as the polymorphic type used by SearchRequest.VectorQueries.
+func (q Query) classification() searchindex.VectorQueryClassification {
+	kind := q.kind
+	var fields *string
+	if len(q.fields) > 0 {
+		fields = ptr(strings.Join(q.fields, ","))
+	}
+	if kind == searchindex.VectorQueryKindText {
+		return &searchindex.VectorizableTextQuery{
+			Kind:         &kind,
+			Text:         ptr(q.text),
+			Fields:       fields,
+			K:            q.k,
+			Weight:       q.weight,
+			Exhaustive:   q.exhaustive,
+			Oversampling: q.oversampling,
+		}
+	}
+	v := make([]*float32, len(q.vector))
+	for i, x := range q.vector {
+		v[i] = &x
+	}
+	return &searchindex.VectorizedQuery{
+		Kind:         &kind,
+		Vector:       v,
+		Fields:       fields,
+		K:            q.k,
+		Weight:       q.weight,
+		Exhaustive:   q.exhaustive,
+		Oversampling: q.oversampling,
+	}
+}
+
+// Request builds a vector or hybrid SearchRequest. Its methods return modified copies.
+type Request struct {
+	queries    []Query
+	searchText *string
+	filter     *string
+	filterMode *searchindex.VectorFilterMode
+	top        *int32
+	selects    []string
+}
+
+// NewRequest creates a request running queries. With several queries, their results are merged.
+func NewRequest(queries ...Query) Request {
+	return Request{queries: append([]Query(nil), queries...)}
+}
+
+// Add adds queries to the request.
+func (r Request) Add(queries ...Query) Request {
+	r.queries = append(append([]Query(nil), r.queries...), queries...)
+	return r
+}
+
+// SearchText sets the text query of a hybrid search.
+func (r Request) SearchText(text string) Request {
+	r.searchText = &text
+	return r
+}
+
+// Filter sets the OData filter applied to the text and vector queries.
+func (r Request) Filter(filter string) Request {
+	r.filter = &filter
+	return r
+}
+
+// FilterMode sets whether the filter is applied before or after the vector search. The service
+// default is searchindex.VectorFilterModePreFilter.
+func (r Request) FilterMode(mode searchindex.VectorFilterMode) Request {
+	r.filterMode = &mode
+	return r
+}
+
+// Top sets the number of results to return. Without it, the service returns up to 50 results, but
+// at most the sum of the queries' K for pure vector queries.
+func (r Request) Top(n int32) Request {
+	r.top = &n
+	return r
+}
+
+// Select sets the fields to return. Vector fields are only returned if selected explicitly.
+func (r Request) Select(fields ...string) Request {
+	r.selects = append([]string(nil), fields...)
+	return r
+}
+
+// Build validates the request and returns it as a SearchRequest.
+func (r Request) Build() (searchindex.SearchRequest, error) {
+	return r.Apply(searchindex.SearchRequest{})
+}
+
+// BuildFor is like Build, but also validates the queries against index: their fields must be
+// vector fields, vectors must match the fields' VectorSearchDimensions, and text queries need a
+// vectorizer in the fields' vector search profile.
+func (r Request) BuildFor(index *searchservice.SearchIndex) (searchindex.SearchRequest, error) {
+	if index == nil {
+		return searchindex.SearchRequest{}, errors.New("index is required")
+	}
+	var errs []error
+	for i, q := range r.queries {
+		if err := q.validateFor(index); err != nil {
+			errs = append(errs, fmt.Errorf("vector query %d: %w", i, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return searchindex.SearchRequest{}, err
+	}
+	return r.Build()
+}
+
+// Apply validates the request and sets its queries and the options set on r in searchRequest,
+// keeping its other options such as facets or a semantic configuration.
+func (r Request) Apply(searchRequest searchindex.SearchRequest) (searchindex.SearchRequest, error) {
+	if len(r.queries) == 0 && r.searchText == nil && searchRequest.SearchText == nil {
+		return searchRequest, errors.New("request has neither vector queries nor search text")
+	}
+	var errs []error
+	for i, q := range r.queries {
+		if err := q.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("vector query %d: %w", i, err))
+		}
+	}
+	if r.top != nil && *r.top < 0 {
+		errs = append(errs, fmt.Errorf("top must not be negative, got %d", *r.top))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return searchRequest, err
+	}
+
+	queries := make([]searchindex.VectorQueryClassification, 0, len(searchRequest.VectorQueries)+len(r.queries))
+	queries = append(queries, searchRequest.VectorQueries...)
+	for _, q := range r.queries {
+		queries = append(queries, q.classification())
+	}
+	searchRequest.VectorQueries = queries
+	if r.searchText != nil {
+		searchRequest.SearchText = r.searchText
+	}
+	if r.filter != nil {
+		searchRequest.Filter = r.filter
+	}
+	if r.filterMode != nil {
+		searchRequest.VectorFilterMode = r.filterMode
+	}
+	if r.top != nil {
+		searchRequest.Top = r.top
+	}
+	if r.selects != nil {
+		searchRequest.Select = ptr(strings.Join(r.selects, ","))
+	}
+	return searchRequest, nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
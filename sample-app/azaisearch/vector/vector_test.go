@@ -0,0 +1,173 @@
+package vector
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchindex"
+	"sample-app/azaisearch/internal/services/search/2025-09-01/searchservice"
+)
+
+func TestQueryValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   Query
+		wantErr string
+	}{
+		{"vector", Vector([]float32{1, 2}).Fields("v"), ""},
+		{"text", Text("beach").Fields("v"), ""},
+		{"all options", Vector([]float32{1}).Fields("v").K(5).Weight(0.5).Exhaustive(true).Oversampling(1), ""},
+		{"empty vector", Vector(nil).Fields("v"), "vector is empty"},
+		{"empty text", Text(" ").Fields("v"), "text is empty"},
+		{"no fields", Vector([]float32{1}), "no fields to search"},
+		{"zero k", Vector([]float32{1}).Fields("v").K(0), "k must be positive, got 0"},
+		{"negative weight", Vector([]float32{1}).Fields("v").Weight(-1), "weight must be positive, got -1"},
+		{"oversampling below 1", Vector([]float32{1}).Fields("v").Oversampling(0.5), "oversampling must be at least 1, got 0.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.validate()
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("validate() error = %v", err)
+			case tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr):
+				t.Errorf("validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQueryValidateFor(t *testing.T) {
+	field := func(name string, typ searchservice.SearchFieldDataType, dims int32, profile string) *searchservice.SearchField {
+		f := &searchservice.SearchField{Name: ptr(name), Type: &typ}
+		if dims > 0 {
+			f.VectorSearchDimensions = &dims
+		}
+		if profile != "" {
+			f.VectorSearchProfileName = &profile
+		}
+		return f
+	}
+	index := &searchservice.SearchIndex{
+		Name: ptr("hotels"),
+		Fields: []*searchservice.SearchField{
+			field("id", searchservice.SearchFieldDataTypeString, 0, ""),
+			field("tags", "Collection(Edm.String)", 0, ""),
+			field("plain", "Collection(Edm.Single)", 3, "plain"),
+			field("vectorized", "Collection(Edm.Single)", 3, "vectorized"),
+			field("scalar", searchservice.SearchFieldDataTypeDouble, 3, ""),
+		},
+		VectorSearch: &searchservice.VectorSearch{
+			Profiles: []*searchservice.VectorSearchProfile{
+				{Name: ptr("plain"), AlgorithmConfigurationName: ptr("hnsw")},
+				{Name: ptr("vectorized"), AlgorithmConfigurationName: ptr("hnsw"), VectorizerName: ptr("openai")},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		query   Query
+		wantErr []string
+	}{
+		{"vector", Vector([]float32{1, 2, 3}).Fields("plain", "vectorized"), nil},
+		{"text with vectorizer", Text("beach").Fields("vectorized"), nil},
+		{"dimension mismatch", Vector([]float32{1, 2}).Fields("plain"), []string{`field "plain" has 3 dimensions, but the vector has 2`}},
+		{"text without vectorizer", Text("beach").Fields("plain"), []string{`field "plain" has no vectorizer to vectorize text queries`}},
+		{"non-vector field", Vector([]float32{1, 2, 3}).Fields("tags"), []string{`field "tags" is not a vector field`}},
+		{"scalar field", Vector([]float32{1, 2, 3}).Fields("scalar"), []string{`field "scalar" is not a vector field`}},
+		{"unknown field", Vector([]float32{1, 2, 3}).Fields("missing"), []string{"missing"}},
+		{"all errors", Vector([]float32{1}).Fields("id", "plain"), []string{`field "id" is not a vector field`, `field "plain" has 3 dimensions`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.validateFor(index)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("validateFor() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("validateFor() error = nil, want %q", tt.wantErr)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("validateFor() error = %v, want %q", err, want)
+				}
+			}
+		})
+	}
+
+	if _, err := NewRequest(Text("beach").Fields("plain")).BuildFor(index); err == nil || !strings.Contains(err.Error(), "vector query 0") {
+		t.Errorf("BuildFor() error = %v, want an error for vector query 0", err)
+	}
+	if _, err := NewRequest(Text("beach").Fields("vectorized")).BuildFor(nil); err == nil {
+		t.Error("BuildFor(nil) error = nil")
+	}
+}
+
+func TestRequestApply(t *testing.T) {
+	existing := &searchindex.VectorizableTextQuery{Kind: ptr(searchindex.VectorQueryKindText), Text: ptr("pool"), Fields: ptr("v")}
+	facets := []*string{ptr("category")}
+	req := searchindex.SearchRequest{
+		SearchText:            ptr("hotel"),
+		Facets:                facets,
+		SemanticConfiguration: ptr("default"),
+		VectorQueries:         []searchindex.VectorQueryClassification{existing},
+	}
+
+	got, err := NewRequest(Vector([]float32{1, 2}).Fields("v").K(5)).Filter("rating ge 4").Top(10).Select("id", "name").Apply(req)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if len(got.VectorQueries) != 2 || got.VectorQueries[0] != existing {
+		t.Fatalf("Apply() vector queries = %v, want the existing query followed by the new one", got.VectorQueries)
+	}
+	vq, ok := got.VectorQueries[1].(*searchindex.VectorizedQuery)
+	if !ok {
+		t.Fatalf("Apply() vector query 1 = %T, want *searchindex.VectorizedQuery", got.VectorQueries[1])
+	}
+	if *vq.Fields != "v" || *vq.K != 5 || len(vq.Vector) != 2 || *vq.Vector[0] != 1 || *vq.Vector[1] != 2 {
+		t.Errorf("Apply() vector query 1 = %+v", vq)
+	}
+	if *got.SearchText != "hotel" {
+		t.Errorf("Apply() search text = %q, want %q", *got.SearchText, "hotel")
+	}
+	if !reflect.DeepEqual(got.Facets, facets) || *got.SemanticConfiguration != "default" {
+		t.Errorf("Apply() facets = %v, semantic configuration = %q, want them unchanged", got.Facets, *got.SemanticConfiguration)
+	}
+	if *got.Filter != "rating ge 4" || *got.Top != 10 || *got.Select != "id,name" {
+		t.Errorf("Apply() filter = %q, top = %d, select = %q", *got.Filter, *got.Top, *got.Select)
+	}
+	if len(req.VectorQueries) != 1 {
+		t.Errorf("Apply() modified the vector queries of its argument")
+	}
+
+	got, err = NewRequest().SearchText("beach").Apply(req)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if *got.SearchText != "beach" || len(got.VectorQueries) != 1 {
+		t.Errorf("Apply() search text = %q, vector queries = %d, want %q, 1", *got.SearchText, len(got.VectorQueries), "beach")
+	}
+}
+
+func TestRequestApplyErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		request Request
+		wantErr string
+	}{
+		{"empty", NewRequest(), "request has neither vector queries nor search text"},
+		{"invalid query", NewRequest(Vector([]float32{1}).Fields("v"), Vector(nil).Fields("v")), "vector query 1: vector is empty"},
+		{"negative top", NewRequest(Vector([]float32{1}).Fields("v")).Top(-1), "top must not be negative, got -1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.request.Build(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Build() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -29,17 +29,14 @@ func main() {
 
 	ctx := context.Background()
 
-	// 1. Create the index (if it does not already exist)
+	// Decide auth strategy; a single root client shares its pipeline with all sub-clients
 	var (
-		indexesClient *azaisearch.IndexesClient
-		err           error
+		client *azaisearch.Client
+		err    error
 	)
-
-	// Decide auth strategy
-	useKey := apiKey != ""
-	if useKey {
+	if apiKey != "" {
 		fmt.Println("Using API Key authentication.")
-		indexesClient, err = azaisearch.NewIndexesClientWithSharedKey(endpoint, azcore.NewKeyCredential(apiKey), nil)
+		client, err = azaisearch.NewClientWithSharedKey(endpoint, azcore.NewKeyCredential(apiKey), nil)
 	} else {
 		fmt.Println("No AZSEARCH_API_KEY provided. Falling back to Azure AD (DefaultAzureCredential).")
 		cred, credErr := azidentity.NewDefaultAzureCredential(nil)
@@ -47,12 +44,15 @@ func main() {
 			fmt.Printf("Failed to create DefaultAzureCredential: %v\n", credErr)
 			return
 		}
-		indexesClient, err = azaisearch.NewIndexesClient(endpoint, cred, nil)
+		client, err = azaisearch.NewClient(endpoint, cred, nil)
 	}
 	if err != nil {
 		panic(err)
 	}
 
+	// 1. Create the index (if it does not already exist)
+	indexesClient := client.Indexes()
+
 	// Define fields
 	var (
 		fieldKeyName      = "id"
@@ -85,20 +85,7 @@ func main() {
 	}
 
 	// 2. Index a sample document
-	var docsClient *azaisearch.DocumentsClient
-	if useKey {
-		docsClient, err = azaisearch.NewDocumentsClientWithSharedKey(endpoint, indexName, azcore.NewKeyCredential(apiKey), nil)
-	} else {
-		cred, credErr := azidentity.NewDefaultAzureCredential(nil)
-		if credErr != nil {
-			fmt.Printf("Failed to create DefaultAzureCredential: %v\n", credErr)
-			return
-		}
-		docsClient, err = azaisearch.NewDocumentsClient(endpoint, indexName, cred, nil)
-	}
-	if err != nil {
-		panic(err)
-	}
+	docsClient := client.Documents(indexName)
 
 	docKey := "1"
 	sampleDoc := map[string]any{